@@ -0,0 +1,64 @@
+package wmenu
+
+import (
+	"os"
+
+	"github.com/dixonwille/wlog/v3"
+)
+
+// asker holds what menus and prompts share when asking a question.
+// It keeps track of the tries left so an invalid response can be asked again.
+type asker struct {
+	ui            wlog.UI
+	loopOnInvalid bool
+	clear         bool
+	tries         int
+	header        string
+}
+
+// Creates an asker with a wlog.UI using os.Stdin, os.Stdout, and os.Stderr with concurrency.
+func newAsker() asker {
+	var ui wlog.UI
+	ui = wlog.New(os.Stdin, os.Stdout, os.Stderr)
+	ui = wlog.AddConcurrent(ui)
+	return asker{
+		ui:            ui,
+		loopOnInvalid: false,
+		clear:         false,
+		tries:         3,
+		header:        "",
+	}
+}
+
+// Counts a failed try.
+// If the question should be asked again the error is written and nil is returned.
+// Otherwise the error is returned as a MenuError.
+func (a *asker) failed(err error) error {
+	a.tries = a.tries - 1
+	if !IsMenuErr(err) {
+		err = newMenuError(err, "", a.triesLeft())
+	}
+	if a.loopOnInvalid && a.tries > 0 {
+		if a.clear {
+			a.clearScreen()
+		}
+		a.ui.Error(err.Error())
+		return nil
+	}
+	return err
+}
+
+func (a *asker) triesLeft() int {
+	if a.loopOnInvalid && a.tries > 0 {
+		return a.tries
+	}
+	return 0
+}
+
+// Clears the screen and writes the header back at the top.
+func (a *asker) clearScreen() {
+	Clear()
+	if a.header != "" {
+		a.ui.Success(a.header)
+	}
+}
@@ -0,0 +1,97 @@
+package wmenu
+
+import "io"
+
+// BatchAnswer is the answer BatchConfirm got for a single item.
+type BatchAnswer struct {
+	Yes bool
+	// Inherited is true when the answer came from an earlier yes to all or no to all.
+	Inherited bool
+}
+
+// BatchConfirm asks a yes/no question for each item of a batch (IE every file being deleted).
+// Along with yes and no it accepts yes to all and no to all (a and N by default).
+// Once one of those is answered the rest of the batch is not asked and gets the same answer.
+type BatchConfirm struct {
+	def           DefaultYN
+	words         YesNoWords
+	reader        io.Reader
+	writer        io.Writer
+	errorWriter   io.Writer
+	loopOnInvalid bool
+	all           *bool
+}
+
+// NewBatchConfirm creates a BatchConfirm that uses def as the default answer.
+func NewBatchConfirm(def DefaultYN) *BatchConfirm {
+	return &BatchConfirm{
+		def:   def,
+		words: EnglishYesNo,
+	}
+}
+
+// ChangeReaderWriter changes where the questions listen and write to.
+// reader is where user input is collected.
+// writer and errorWriter is where the questions should write to.
+func (b *BatchConfirm) ChangeReaderWriter(reader io.Reader, writer, errorWriter io.Writer) {
+	b.reader = reader
+	b.writer = writer
+	b.errorWriter = errorWriter
+}
+
+// SetYesNoWords sets the answers accepted, including YesToAll and NoToAll.
+// Default is EnglishYesNo.
+func (b *BatchConfirm) SetYesNoWords(words YesNoWords) {
+	b.words = words
+}
+
+// LoopOnInvalid is used if an invalid answer was given then it will ask the user again.
+func (b *BatchConfirm) LoopOnInvalid() {
+	b.loopOnInvalid = true
+}
+
+// Reset forgets any yes to all or no to all so the next item is asked again.
+func (b *BatchConfirm) Reset() {
+	b.all = nil
+}
+
+// Confirm asks question unless yes to all or no to all was already answered.
+// Errors are of type MenuError.
+func (b *BatchConfirm) Confirm(question string) (BatchAnswer, error) {
+	if b.all != nil {
+		return BatchAnswer{Yes: *b.all, Inherited: true}, nil
+	}
+	menu := NewMenu(question)
+	if b.reader != nil {
+		menu.ChangeReaderWriter(b.reader, b.writer, b.errorWriter)
+	}
+	if b.loopOnInvalid {
+		menu.LoopOnInvalid()
+	}
+	menu.ynToAll = true
+	menu.SetYesNoWords(b.words)
+	menu.IsYesNo(b.def)
+
+	var answer BatchAnswer
+	menu.Action(func(opts []Opt) error {
+		//IDs follow the order of the yes/no options
+		switch opts[0].ID {
+		case 0:
+			answer.Yes = true
+		case 1:
+			answer.Yes = false
+		case 2, 3:
+			all := opts[0].ID == 2
+			b.all = &all
+			answer.Yes = all
+		default:
+			return newMenuError(ErrNoResponse, "", 0)
+		}
+		return nil
+	})
+	err := menu.Run()
+	if err != nil {
+		return BatchAnswer{}, err
+	}
+	return answer, nil
+}
@@ -0,0 +1,71 @@
+package wmenu
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var batchCases = []struct {
+	input    string
+	def      DefaultYN
+	expected []BatchAnswer
+}{
+	{"y\r\nn\r\ny\r\n", DefY, []BatchAnswer{{true, false}, {false, false}, {true, false}}},
+	{"n\r\na\r\n", DefY, []BatchAnswer{{false, false}, {true, false}, {true, true}}},
+	{"y\r\nN\r\n", DefY, []BatchAnswer{{true, false}, {false, false}, {false, true}}},
+	{"none\r\n", DefY, []BatchAnswer{{false, false}, {false, true}, {false, true}}},
+	{"ALL\r\n", DefN, []BatchAnswer{{true, false}, {true, true}, {true, true}}},
+	{"\r\n\r\n\r\n", DefN, []BatchAnswer{{false, false}, {false, false}, {false, false}}},
+}
+
+func TestBatchConfirm(t *testing.T) {
+	for _, c := range batchCases {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		batch := NewBatchConfirm(c.def)
+		batch.ChangeReaderWriter(reader, stdOut, stdOut)
+		var actual []BatchAnswer
+		for _, file := range []string{"a.txt", "b.txt", "c.txt"} {
+			answer, err := batch.Confirm("Delete " + file + "?")
+			require.NoError(t, err, c.input)
+			actual = append(actual, answer)
+		}
+		assert.Equal(t, c.expected, actual, c.input)
+	}
+}
+
+func TestBatchConfirmQuestion(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("x\r\nj\r\nk\r\n"))
+	batch := NewBatchConfirm(DefN)
+	batch.ChangeReaderWriter(reader, stdOut, stdOut)
+	batch.SetYesNoWords(GermanYesNo)
+	batch.LoopOnInvalid()
+	answer, err := batch.Confirm("Löschen?")
+	require.NoError(t, err)
+	assert.Equal(t, BatchAnswer{Yes: true}, answer)
+	answer, err = batch.Confirm("Löschen?")
+	require.NoError(t, err)
+	assert.Equal(t, BatchAnswer{Yes: false}, answer)
+
+	lines := strings.Split(stdOut.String(), "\n")
+	assert.Equal(t, "Löschen? (j/N) [a = ja für alle, k = nein für alle]", lines[0])
+	assert.Equal(t, "invalid response: x", lines[1])
+
+	batch.Reset()
+	_, err = batch.Confirm("Löschen?")
+	assert.Error(t, err)
+}
+
+func TestBatchConfirmNoDefault(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("\r\n"))
+	batch := NewBatchConfirm(0)
+	batch.ChangeReaderWriter(reader, stdOut, stdOut)
+	_, err := batch.Confirm("Delete?")
+	assert.True(t, IsNoResponseErr(err))
+}
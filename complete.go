@@ -0,0 +1,77 @@
+package wmenu
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattn/go-isatty"
+)
+
+// completer returns the completed response and the candidates if the response could not be completed to one.
+type completer func(res string) (string, []string)
+
+// readCompleted calls ask and reads a line from reader if it is a terminal, completing the response when tab is pressed.
+// ok is false when reader is not a terminal, in which case ask was not called and nothing was read.
+func readCompleted(reader io.Reader, writer io.Writer, ask func(), complete completer) (res string, ok bool, err error) {
+	f, isFile := reader.(*os.File)
+	if !isFile || !isatty.IsTerminal(f.Fd()) {
+		return "", false, nil
+	}
+	restore, err := rawInput(f.Fd())
+	if err != nil {
+		return "", false, nil
+	}
+	defer restore()
+	ask()
+	res, err = readLine(reader, writer, complete)
+	return res, true, err
+}
+
+// readLine reads keys one at a time from reader until enter is pressed.
+// Keys are echoed to writer, backspace removes the last character, and tab completes the response.
+// If the response can not be completed to one, the candidates are written and the line is written again.
+// Only a byte is read at a time so nothing typed after enter is lost.
+func readLine(reader io.Reader, writer io.Writer, complete completer) (string, error) {
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		n, err := reader.Read(b)
+		if n == 0 && err == nil {
+			continue
+		}
+		if err != nil {
+			if err == io.EOF && len(line) > 0 {
+				fmt.Fprint(writer, "\n")
+				return string(line), nil
+			}
+			return "", err
+		}
+		switch c := b[0]; {
+		case c == '\r' || c == '\n':
+			fmt.Fprint(writer, "\n")
+			return string(line), nil
+		case c == '\t':
+			res, candidates := complete(string(line))
+			if len(candidates) > 1 {
+				fmt.Fprint(writer, "\n"+strings.Join(candidates, "  ")+"\n"+res)
+			} else {
+				fmt.Fprint(writer, strings.TrimPrefix(res, string(line)))
+			}
+			line = []byte(res)
+		case c == 0x7f || c == '\b':
+			if len(line) > 0 {
+				_, size := utf8.DecodeLastRune(line)
+				line = line[:len(line)-size]
+				fmt.Fprint(writer, "\b \b")
+			}
+		case c < ' ':
+			//ignore other control keys
+		default:
+			line = append(line, c)
+			writer.Write(b)
+		}
+	}
+}
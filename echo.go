@@ -0,0 +1,26 @@
+package wmenu
+
+import (
+	"io"
+	"os"
+
+	"github.com/mattn/go-isatty"
+)
+
+// hideInput turns off echo while reading from reader if it is a terminal.
+// The returned function turns echo back on and reports if echo was turned off.
+// When reader is not a terminal (IE piped input) nothing is changed.
+func hideInput(reader io.Reader) func() bool {
+	f, ok := reader.(*os.File)
+	if !ok || !isatty.IsTerminal(f.Fd()) {
+		return func() bool { return false }
+	}
+	restore, err := disableEcho(f.Fd())
+	if err != nil {
+		return func() bool { return false }
+	}
+	return func() bool {
+		restore()
+		return true
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!windows
+
+package wmenu
+
+import "errors"
+
+func disableEcho(fd uintptr) (func(), error) {
+	return nil, errors.New("hiding input is not supported")
+}
+
+func rawInput(fd uintptr) (func(), error) {
+	return nil, errors.New("raw input is not supported")
+}
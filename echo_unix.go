@@ -0,0 +1,41 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package wmenu
+
+import "golang.org/x/sys/unix"
+
+func disableEcho(fd uintptr) (func(), error) {
+	return setTermios(fd, func(t *unix.Termios) {
+		t.Lflag &^= unix.ECHO
+		t.Lflag |= unix.ICANON | unix.ISIG
+		t.Iflag |= unix.ICRNL
+	})
+}
+
+// rawInput hands every key to the reader as it is pressed without echoing it.
+// Signals like ctrl+c still work.
+func rawInput(fd uintptr) (func(), error) {
+	return setTermios(fd, func(t *unix.Termios) {
+		t.Lflag &^= unix.ECHO | unix.ICANON
+		t.Lflag |= unix.ISIG
+		t.Iflag |= unix.ICRNL
+		t.Cc[unix.VMIN] = 1
+		t.Cc[unix.VTIME] = 0
+	})
+}
+
+func setTermios(fd uintptr, change func(*unix.Termios)) (func(), error) {
+	old, err := unix.IoctlGetTermios(int(fd), ioctlGetTermios)
+	if err != nil {
+		return nil, err
+	}
+	t := *old
+	change(&t)
+	if err := unix.IoctlSetTermios(int(fd), ioctlSetTermios, &t); err != nil {
+		return nil, err
+	}
+	return func() {
+		unix.IoctlSetTermios(int(fd), ioctlSetTermios, old)
+	}, nil
+}
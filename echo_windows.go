@@ -0,0 +1,29 @@
+//go:build windows
+// +build windows
+
+package wmenu
+
+import (
+	"errors"
+
+	"golang.org/x/sys/windows"
+)
+
+func disableEcho(fd uintptr) (func(), error) {
+	var old uint32
+	if err := windows.GetConsoleMode(windows.Handle(fd), &old); err != nil {
+		return nil, err
+	}
+	hidden := old&^windows.ENABLE_ECHO_INPUT | windows.ENABLE_PROCESSED_INPUT | windows.ENABLE_LINE_INPUT
+	if err := windows.SetConsoleMode(windows.Handle(fd), hidden); err != nil {
+		return nil, err
+	}
+	return func() {
+		windows.SetConsoleMode(windows.Handle(fd), old)
+	}, nil
+}
+
+// rawInput is not supported on windows so completion falls back to reading a line.
+func rawInput(fd uintptr) (func(), error) {
+	return nil, errors.New("raw input is not supported")
+}
@@ -0,0 +1,149 @@
+package wmenu
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// EditorPrompt is a Prompt that opens a text editor for long responses (IE a commit message).
+// The editor is $VISUAL, then $EDITOR, then vi (notepad on windows) unless SetEditor is used.
+// Lines starting with the comment prefix are removed from the response.
+// The editor is opened again with what was written the last time if LoopOnInvalid is activated so it can be fixed.
+// The response is typed in the editor so the response color of AddColor is not used.
+type EditorPrompt struct {
+	Prompt
+	template string
+	comment  string
+	editor   string
+	ext      string
+}
+
+// NewEditorPrompt creates an EditorPrompt with a wlog.UI as the writer.
+// question is written before the editor is opened, use an empty string to not write anything.
+// Nothing is trimmed from the response by default as blank lines around it are already removed.
+func NewEditorPrompt(question string) *EditorPrompt {
+	p := &EditorPrompt{Prompt: *NewPrompt(question), comment: "#", ext: ".txt"}
+	p.trim = ""
+	return p
+}
+
+// SetTemplate sets the text the editor is opened with.
+// Comment lines can be used to explain what should be written.
+func (p *EditorPrompt) SetTemplate(template string) {
+	p.template = template
+}
+
+// SetCommentPrefix sets what lines that are removed from the response start with.
+// Default is #. Use an empty string to keep every line.
+func (p *EditorPrompt) SetCommentPrefix(prefix string) {
+	p.comment = prefix
+}
+
+// SetEditor sets the command used to open the editor instead of $VISUAL or $EDITOR.
+// The command is split on spaces (IE "code --wait") and the file to edit is added to the end.
+func (p *EditorPrompt) SetEditor(command string) {
+	p.editor = command
+}
+
+// SetExtension sets the extension of the file being edited (IE .yaml) so editors can highlight it.
+// Default is .txt.
+func (p *EditorPrompt) SetExtension(ext string) {
+	p.ext = ext
+}
+
+// Run is used to execute the prompt.
+// It will open the editor and return the validated response without comment lines.
+// It will only clear the screen if ClearOnPromptRun is activated.
+// Errors opening the editor are returned as is, otherwise errors are of type MenuError.
+// The response can be long so errors do not hold it in Res.
+func (p *EditorPrompt) Run() (string, error) {
+	if p.clear {
+		Clear()
+	}
+	text := p.template
+	for {
+		var res string
+		var err error
+		text, err = p.edit(text)
+		if err != nil {
+			return "", err
+		}
+		res, err = p.validate(strings.Trim(p.strip(text), p.trim))
+		if err == nil {
+			return res, nil
+		}
+		if e, ok := err.(*MenuError); ok {
+			e.Res = ""
+		}
+		if err = p.failed(err); err != nil {
+			return "", err
+		}
+	}
+}
+
+// Writes text to a temporary file, opens the editor on it, and returns what was saved.
+func (p *EditorPrompt) edit(text string) (string, error) {
+	question := p.question
+	if p.hasDef {
+		question += " (" + p.def + ")"
+	}
+	if question != "" {
+		p.ui.Output(question)
+	}
+	f, err := ioutil.TempFile("", "wmenu-*"+p.ext)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(text)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", err
+	}
+	args := strings.Fields(p.editorCommand())
+	cmd := exec.Command(args[0], append(args[1:], f.Name())...)
+	cmd.Stdin = p.reader
+	cmd.Stdout = p.writer
+	cmd.Stderr = p.errWriter
+	if err = cmd.Run(); err != nil {
+		return "", err
+	}
+	b, err := ioutil.ReadFile(f.Name())
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (p *EditorPrompt) editorCommand() string {
+	if strings.TrimSpace(p.editor) != "" {
+		return p.editor
+	}
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor := os.Getenv(env); strings.TrimSpace(editor) != "" {
+			return editor
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// Removes comment lines and the blank lines around the response.
+func (p *EditorPrompt) strip(text string) string {
+	text = strings.Replace(text, "\r\n", "\n", -1)
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if p.comment != "" && strings.HasPrefix(line, p.comment) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimLeft(strings.TrimRight(strings.Join(lines, "\n"), " \t\n"), "\n")
+}
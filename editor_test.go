@@ -0,0 +1,171 @@
+package wmenu
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dixonwille/wlog/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeEditor writes a script that saves the file it was opened on to seen.N
+// and replaces it with the Nth of saves.
+func fakeEditor(t *testing.T, saves ...string) (string, string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake editor is a shell script")
+	}
+	dir, err := ioutil.TempDir("", "wmenu")
+	require.NoError(t, err)
+	script := `n=$(cat "$0.count" 2>/dev/null || echo 0)
+n=$((n+1))
+echo $n > "$0.count"
+cp "$1" "$(dirname "$0")/seen.$n"
+cp "$0.$n" "$1"
+`
+	editor := filepath.Join(dir, "editor.sh")
+	require.NoError(t, ioutil.WriteFile(editor, []byte(script), 0700))
+	for i, save := range saves {
+		require.NoError(t, ioutil.WriteFile(editor+"."+strconv.Itoa(i+1), []byte(save), 0600))
+	}
+	return "sh " + editor, dir
+}
+
+var editorCases = []struct {
+	save     string
+	expected string
+	err      error
+}{
+	{"Fix typo\n\nIn the README.\n# Please enter the message.\n", "Fix typo\n\nIn the README.", nil},
+	{"\n\n  key: value\n\n", "  key: value", nil},
+	{"# Please enter the message.\n\n", "", ErrNoResponse},
+	{"WIP\n", "", ErrInvalid},
+}
+
+func TestEditorPrompt(t *testing.T) {
+	for _, c := range editorCases {
+		stdOut := initTest()
+		editor, dir := fakeEditor(t, c.save)
+		prompt := NewEditorPrompt("")
+		prompt.ChangeReaderWriter(strings.NewReader(""), stdOut, stdOut)
+		prompt.SetEditor(editor)
+		prompt.Validator(func(res string) error {
+			if res == "WIP" {
+				return invalidReason(res, "describe the change")
+			}
+			return nil
+		})
+		actual, err := prompt.Run()
+		if c.err != nil {
+			if assert.IsType(t, &MenuError{}, err, c.save) {
+				assert.Equal(t, c.err, err.(*MenuError).Err, c.save)
+			}
+		} else {
+			assert.NoError(t, err, c.save)
+		}
+		assert.Equal(t, c.expected, actual, c.save)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestEditorPromptReopen(t *testing.T) {
+	stdOut := initTest()
+	editor, dir := fakeEditor(t, "WIP\n# Summary\n", "Add editor prompt\n# Summary\n")
+	defer os.RemoveAll(dir)
+	prompt := NewEditorPrompt("Commit message:")
+	prompt.ChangeReaderWriter(strings.NewReader(""), stdOut, stdOut)
+	prompt.SetEditor(editor)
+	prompt.SetTemplate("\n# Summary\n")
+	prompt.LoopOnInvalid()
+	prompt.Validator(func(res string) error {
+		if res == "WIP" {
+			return errors.New("describe the change")
+		}
+		return nil
+	})
+	actual, err := prompt.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, "Add editor prompt", actual)
+
+	seen, err := ioutil.ReadFile(filepath.Join(dir, "seen.1"))
+	require.NoError(t, err)
+	assert.Equal(t, "\n# Summary\n", string(seen))
+	seen, err = ioutil.ReadFile(filepath.Join(dir, "seen.2"))
+	require.NoError(t, err)
+	assert.Equal(t, "WIP\n# Summary\n", string(seen))
+
+	lines := strings.Split(stdOut.String(), "\n")
+	assert.Equal(t, "Commit message:", lines[0])
+	assert.Equal(t, "describe the change", lines[1])
+	assert.Equal(t, "Commit message:", lines[2])
+}
+
+func TestEditorPromptCommand(t *testing.T) {
+	prompt := NewEditorPrompt("")
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if value, ok := os.LookupEnv(env); ok {
+			defer os.Setenv(env, value)
+		} else {
+			defer os.Unsetenv(env)
+		}
+	}
+	os.Setenv("VISUAL", "code --wait")
+	os.Setenv("EDITOR", "nano")
+	assert.Equal(t, "code --wait", prompt.editorCommand())
+	os.Setenv("VISUAL", "")
+	assert.Equal(t, "nano", prompt.editorCommand())
+	prompt.SetEditor("emacs -nw")
+	assert.Equal(t, "emacs -nw", prompt.editorCommand())
+}
+
+func TestEditorPromptShared(t *testing.T) {
+	for _, c := range []struct {
+		save     string
+		expected string
+		err      error
+	}{
+		{"# Branch name\n", "main", nil},
+		{"  feature/editor  \n", "feature/editor", nil},
+		{"Feature\n", "", ErrInvalid},
+	} {
+		stdOut := initTest()
+		editor, dir := fakeEditor(t, c.save)
+		prompt := NewEditorPrompt("Branch?")
+		prompt.ChangeReaderWriter(strings.NewReader(""), stdOut, stdOut)
+		prompt.SetEditor(editor)
+		prompt.AddColor(wlog.None, wlog.None, wlog.None)
+		prompt.Default("main")
+		prompt.SetTrim(" ")
+		prompt.Pattern(regexp.MustCompile(`^[a-z/]+$`))
+		actual, err := prompt.Run()
+		if c.err != nil {
+			if assert.IsType(t, &MenuError{}, err, c.save) {
+				assert.Equal(t, c.err, err.(*MenuError).Err, c.save)
+				assert.Empty(t, err.(*MenuError).Res, c.save)
+			}
+		} else {
+			assert.NoError(t, err, c.save)
+		}
+		assert.Equal(t, c.expected, actual, c.save)
+		assert.True(t, strings.HasPrefix(stdOut.String(), "Branch? (main)\n"), c.save)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestEditorPromptMissing(t *testing.T) {
+	stdOut := initTest()
+	prompt := NewEditorPrompt("")
+	prompt.ChangeReaderWriter(strings.NewReader(""), stdOut, stdOut)
+	prompt.SetEditor("wmenu-editor-that-does-not-exist")
+	prompt.LoopOnInvalid()
+	_, err := prompt.Run()
+	assert.Error(t, err)
+	assert.False(t, IsMenuErr(err))
+}
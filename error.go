@@ -1,6 +1,9 @@
 package wmenu
 
-import "errors"
+import (
+	"errors"
+	"strings"
+)
 
 var (
 	//ErrInvalid is returned if a response from user was an invalid option
@@ -14,6 +17,46 @@ var (
 
 	//ErrDuplicate is returned is a user selects an option twice
 	ErrDuplicate = errors.New("duplicated response")
+
+	//ErrAmbiguous is returned if a response matches the text of more than one option
+	ErrAmbiguous = errors.New("ambiguous response")
+
+	//ErrTooFew is returned if fewer options were selected than the menu's minimum
+	ErrTooFew = errors.New("too few responses")
+
+	//ErrOverMax is returned if more options were selected than the menu's maximum
+	ErrOverMax = errors.New("more than the maximum responses")
+
+	//ErrDisabled is returned if a user selects a disabled option
+	ErrDisabled = errors.New("option disabled")
+
+	//ErrConflict is returned if a user selects options that can not be selected together
+	ErrConflict = errors.New("conflicting responses")
+
+	//ErrMissingRequired is returned if a user selects an option without the options it requires
+	ErrMissingRequired = errors.New("missing required response")
+
+	//ErrCanceled is returned if a user does not confirm the selected options
+	ErrCanceled = errors.New("selection canceled")
+
+	//ErrPhraseMismatch is returned if a user does not type the phrase an option needs to be confirmed
+	ErrPhraseMismatch = errors.New("phrase does not match")
+
+	//ErrKeyCollision is returned when adding an option whose key can not be told apart from another option
+	ErrKeyCollision = errors.New("option key collision")
+
+	//ErrMismatch is returned if a confirmation entry does not match the first response
+	ErrMismatch = errors.New("responses do not match")
+
+	//ErrQuit is returned if a user selects the quit option while navigating menus.
+	//Return it from a function to stop Menu.RunLoop, either as is or as the Err of a MenuError
+	ErrQuit = errors.New("quit")
+
+	//ErrUnknownTitle is returned if Exclusive or Requires uses a title that no option of the menu has
+	ErrUnknownTitle = errors.New("unknown option title")
+
+	//ErrNotNavigable is returned if a yes/no menu is navigated as it has no place for the Back and Quit options
+	ErrNotNavigable = errors.New("yes/no menu can not be navigated")
 )
 
 // MenuError records menu errors
@@ -21,14 +64,30 @@ type MenuError struct {
 	Err       error
 	Res       string
 	TriesLeft int
+	// Matches holds the text of every option an ambiguous response could mean.
+	Matches []string
+	// Suggestions holds the options closest to an invalid response as they are shown (IE 1) staging).
+	Suggestions []string
+	// Reason explains the error further (IE why an option is disabled).
+	Reason string
 }
 
 // Error prints the error in an easy to read string.
 func (e *MenuError) Error() string {
+	msg := e.Err.Error()
 	if e.Res != "" {
-		return e.Err.Error() + ": " + e.Res
+		msg += ": " + e.Res
+	}
+	if e.Reason != "" {
+		msg += " (" + e.Reason + ")"
+	}
+	if len(e.Matches) > 0 {
+		msg += " (" + strings.Join(e.Matches, ", ") + ")"
 	}
-	return e.Err.Error()
+	if len(e.Suggestions) > 0 {
+		msg += " (did you mean " + strings.Join(e.Suggestions, " or ") + "?)"
+	}
+	return msg
 }
 
 func newMenuError(err error, res string, tries int) *MenuError {
@@ -39,6 +98,12 @@ func newMenuError(err error, res string, tries int) *MenuError {
 	}
 }
 
+func invalidReason(res, reason string) *MenuError {
+	err := newMenuError(ErrInvalid, res, 0)
+	err.Reason = reason
+	return err
+}
+
 // IsInvalidErr checks to see if err is of type invalid error returned by menu.
 func IsInvalidErr(err error) bool {
 	e, ok := err.(*MenuError)
@@ -75,6 +140,123 @@ func IsDuplicateErr(err error) bool {
 	return false
 }
 
+// IsAmbiguousErr checks to see if err is of type ambiguous returned by menu.
+func IsAmbiguousErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrAmbiguous {
+		return true
+	}
+	return false
+}
+
+// IsTooFewErr checks to see if err is of type too few returned by menu.
+func IsTooFewErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrTooFew {
+		return true
+	}
+	return false
+}
+
+// IsOverMaxErr checks to see if err is of type over max returned by menu.
+func IsOverMaxErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrOverMax {
+		return true
+	}
+	return false
+}
+
+// IsDisabledErr checks to see if err is of type disabled returned by menu.
+func IsDisabledErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrDisabled {
+		return true
+	}
+	return false
+}
+
+// IsConflictErr checks to see if err is of type conflict returned by menu.
+func IsConflictErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrConflict {
+		return true
+	}
+	return false
+}
+
+// IsMissingRequiredErr checks to see if err is of type missing required returned by menu.
+func IsMissingRequiredErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrMissingRequired {
+		return true
+	}
+	return false
+}
+
+// IsCanceledErr checks to see if err is of type canceled returned by menu.
+func IsCanceledErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrCanceled {
+		return true
+	}
+	return false
+}
+
+// IsPhraseMismatchErr checks to see if err is of type phrase mismatch returned by menu.
+func IsPhraseMismatchErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrPhraseMismatch {
+		return true
+	}
+	return false
+}
+
+// IsKeyCollisionErr checks to see if err is of type key collision returned by menu.
+func IsKeyCollisionErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrKeyCollision {
+		return true
+	}
+	return false
+}
+
+// IsMismatchErr checks to see if err is of type mismatch error returned by prompt.
+func IsMismatchErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrMismatch {
+		return true
+	}
+	return false
+}
+
+// IsQuitErr checks to see if err is of type quit error returned by menu.
+func IsQuitErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrQuit {
+		return true
+	}
+	return false
+}
+
+// IsUnknownTitleErr checks to see if err is of type unknown title returned by menu.
+func IsUnknownTitleErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrUnknownTitle {
+		return true
+	}
+	return false
+}
+
+// IsNotNavigableErr checks to see if err is of type not navigable returned by menu.
+func IsNotNavigableErr(err error) bool {
+	e, ok := err.(*MenuError)
+	if ok && e.Err == ErrNotNavigable {
+		return true
+	}
+	return false
+}
+
 // IsMenuErr checks to see if it is a menu err.
 // This is a general check not a specific one.
 func IsMenuErr(err error) bool {
@@ -12,6 +12,7 @@ var (
 	testDuplicate  = newMenuError(ErrDuplicate, "2", 0)
 	testTooMany    = newMenuError(ErrTooMany, "", 0)
 	testNoResponse = newMenuError(ErrNoResponse, "", 0)
+	testAmbiguous  = &MenuError{Err: ErrAmbiguous, Res: "st", Matches: []string{"staging", "stable"}}
 	errNormal      = errors.New("Opps")
 	errMenu        = newMenuError(errors.New("General"), "", 0)
 )
@@ -44,6 +45,106 @@ func TestIsNoResponseErr(t *testing.T) {
 	assert.False(IsNoResponseErr(errNormal))
 }
 
+func TestIsAmbiguousErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsAmbiguousErr(testAmbiguous))
+	assert.False(IsAmbiguousErr(testInvalid))
+	assert.False(IsAmbiguousErr(errNormal))
+	assert.Equal("ambiguous response: st (staging, stable)", testAmbiguous.Error())
+}
+
+func TestSuggestionsErr(t *testing.T) {
+	err := &MenuError{Err: ErrInvalid, Res: "stg", Suggestions: []string{"1) staging", "3) stable"}}
+	assert.True(t, IsInvalidErr(err))
+	assert.Equal(t, "invalid response: stg (did you mean 1) staging or 3) stable?)", err.Error())
+}
+
+func TestIsTooFewErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsTooFewErr(newMenuError(ErrTooFew, "1 of at least 2", 0)))
+	assert.False(IsTooFewErr(testTooMany))
+	assert.False(IsTooFewErr(errNormal))
+}
+
+func TestIsOverMaxErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsOverMaxErr(newMenuError(ErrOverMax, "4 of at most 3", 0)))
+	assert.False(IsOverMaxErr(testTooMany))
+	assert.False(IsOverMaxErr(errNormal))
+}
+
+func TestIsDisabledErr(t *testing.T) {
+	assert := assert.New(t)
+	err := &MenuError{Err: ErrDisabled, Res: "3", Reason: "requires VPN"}
+	assert.True(IsDisabledErr(err))
+	assert.False(IsDisabledErr(testInvalid))
+	assert.False(IsDisabledErr(errNormal))
+	assert.Equal("option disabled: 3 (requires VPN)", err.Error())
+}
+
+func TestIsConflictErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsConflictErr(newMenuError(ErrConflict, "Postgres, MySQL", 0)))
+	assert.False(IsConflictErr(testInvalid))
+	assert.False(IsConflictErr(errNormal))
+}
+
+func TestIsMissingRequiredErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsMissingRequiredErr(newMenuError(ErrMissingRequired, "Django", 0)))
+	assert.False(IsMissingRequiredErr(testInvalid))
+	assert.False(IsMissingRequiredErr(errNormal))
+}
+
+func TestIsCanceledErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsCanceledErr(newMenuError(ErrCanceled, "n", 0)))
+	assert.False(IsCanceledErr(testInvalid))
+	assert.False(IsCanceledErr(errNormal))
+}
+
+func TestIsPhraseMismatchErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsPhraseMismatchErr(newMenuError(ErrPhraseMismatch, "prod", 0)))
+	assert.False(IsPhraseMismatchErr(testInvalid))
+	assert.False(IsPhraseMismatchErr(errNormal))
+}
+
+func TestIsKeyCollisionErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsKeyCollisionErr(newMenuError(ErrKeyCollision, "q", 0)))
+	assert.False(IsKeyCollisionErr(testInvalid))
+	assert.False(IsKeyCollisionErr(errNormal))
+}
+
+func TestIsMismatchErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsMismatchErr(newMenuError(ErrMismatch, "", 0)))
+	assert.False(IsMismatchErr(testInvalid))
+	assert.False(IsMismatchErr(errNormal))
+}
+
+func TestIsQuitErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsQuitErr(newMenuError(ErrQuit, "q", 0)))
+	assert.False(IsQuitErr(testInvalid))
+	assert.False(IsQuitErr(errNormal))
+}
+
+func TestIsUnknownTitleErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsUnknownTitleErr(newMenuError(ErrUnknownTitle, "Postgress", 0)))
+	assert.False(IsUnknownTitleErr(testInvalid))
+	assert.False(IsUnknownTitleErr(errNormal))
+}
+
+func TestIsNotNavigableErr(t *testing.T) {
+	assert := assert.New(t)
+	assert.True(IsNotNavigableErr(newMenuError(ErrNotNavigable, "", 0)))
+	assert.False(IsNotNavigableErr(testInvalid))
+	assert.False(IsNotNavigableErr(errNormal))
+}
+
 func TestIsMenuError(t *testing.T) {
 	assert := assert.New(t)
 	assert.True(IsMenuErr(testNoResponse))
@@ -51,6 +152,7 @@ func TestIsMenuError(t *testing.T) {
 	assert.True(IsMenuErr(testInvalid))
 	assert.True(IsMenuErr(testTooMany))
 	assert.True(IsMenuErr(testNoResponse))
+	assert.True(IsMenuErr(testAmbiguous))
 	assert.True(IsMenuErr(errMenu))
 	assert.False(IsMenuErr(errNormal))
 }
@@ -25,7 +25,10 @@ var menuItemStrings = map[menuItem]string{
 
 func main() {
 	mm := mainMenu()
-	err := mm.Run()
+	_, err := mm.Navigate()
+	if wmenu.IsQuitErr(err) {
+		return
+	}
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -34,20 +37,13 @@ func main() {
 
 func mainMenu() *wmenu.Menu {
 	menu := wmenu.NewMenu("What is your favorite food?")
-	menu.Option(menuItemStrings[pizza], pizza, true, nil)
-	menu.Option(menuItemStrings[iceCream], iceCream, false, nil)
+	menu.ShowBreadcrumb("Main")
+	menu.Submenu(menuItemStrings[pizza], pizza, toppingsMenu(pizza))
+	menu.Submenu(menuItemStrings[iceCream], iceCream, toppingsMenu(iceCream))
 	menu.Option(menuItemStrings[tacos], tacos, false, func(_ wmenu.Opt) error {
 		fmt.Printf("Tacos are great!\n")
 		return nil
 	})
-	menu.Action(func(opts []wmenu.Opt) error {
-		if len(opts) != 1 {
-			return errors.New("wrong number of options chosen")
-		}
-
-		tm := toppingsMenu(opts[0].Value.(menuItem))
-		return tm.Run()
-	})
 	return menu
 }
 
@@ -6,4 +6,5 @@ require (
 	github.com/dixonwille/wlog/v3 v3.0.4
 	github.com/mattn/go-isatty v0.0.20
 	github.com/stretchr/testify v1.9.0
+	golang.org/x/sys v0.6.0
 )
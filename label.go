@@ -0,0 +1,73 @@
+package wmenu
+
+import (
+	"strconv"
+	"strings"
+)
+
+// LabelScheme turns the number of an option into the label shown to the user and back again.
+// Numbers start at the menu's InitialIndex.
+type LabelScheme interface {
+	// Label returns the label shown for number.
+	Label(number int) string
+	// Number returns the number that label stands for, or false if it is not a label.
+	Number(label string) (int, bool)
+}
+
+var (
+	// NumericLabels labels options with numbers (IE 1 2 3). This is the default.
+	NumericLabels LabelScheme = numericLabels{}
+	// LowerLetterLabels labels options with lowercase letters starting at a for 1 (IE a b ... z aa ab).
+	// Responses are accepted in either case.
+	LowerLetterLabels LabelScheme = letterLabels{base: 'a'}
+	// UpperLetterLabels labels options with uppercase letters starting at A for 1 (IE A B ... Z AA AB).
+	// Responses are accepted in either case.
+	UpperLetterLabels LabelScheme = letterLabels{base: 'A'}
+)
+
+type numericLabels struct{}
+
+func (numericLabels) Label(number int) string {
+	return strconv.Itoa(number)
+}
+
+func (numericLabels) Number(label string) (int, bool) {
+	number, err := strconv.Atoi(label)
+	return number, err == nil
+}
+
+type letterLabels struct {
+	base rune
+}
+
+// Letters count like spreadsheet columns so there is no zero.
+func (l letterLabels) Label(number int) string {
+	if number < 1 {
+		return ""
+	}
+	var label []rune
+	for number > 0 {
+		number--
+		label = append([]rune{l.base + rune(number%26)}, label...)
+		number /= 26
+	}
+	return string(label)
+}
+
+func (l letterLabels) Number(label string) (int, bool) {
+	if label == "" {
+		return 0, false
+	}
+	number := 0
+	for _, r := range strings.ToLower(label) {
+		if r < 'a' || r > 'z' {
+			return 0, false
+		}
+		number = number*26 + int(r-'a') + 1
+		//Stop before the number overflows
+		if number > 1<<24 {
+			return 0, false
+		}
+	}
+	return number, true
+}
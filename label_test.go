@@ -0,0 +1,128 @@
+package wmenu
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var labelCases = []struct {
+	scheme LabelScheme
+	number int
+	label  string
+}{
+	{NumericLabels, 1, "1"},
+	{NumericLabels, 0, "0"},
+	{NumericLabels, -3, "-3"},
+	{NumericLabels, 27, "27"},
+	{LowerLetterLabels, 1, "a"},
+	{LowerLetterLabels, 26, "z"},
+	{LowerLetterLabels, 27, "aa"},
+	{LowerLetterLabels, 52, "az"},
+	{LowerLetterLabels, 703, "aaa"},
+	{UpperLetterLabels, 1, "A"},
+	{UpperLetterLabels, 28, "AB"},
+}
+
+var badLabelCases = []struct {
+	scheme LabelScheme
+	label  string
+}{
+	{NumericLabels, "a"},
+	{NumericLabels, ""},
+	{LowerLetterLabels, "1"},
+	{LowerLetterLabels, ""},
+	{LowerLetterLabels, "a-b"},
+	{LowerLetterLabels, "zzzzzzzzzzzzzzzz"},
+	{UpperLetterLabels, "Ä"},
+}
+
+func TestLabelScheme(t *testing.T) {
+	for _, c := range labelCases {
+		assert.Equal(t, c.label, c.scheme.Label(c.number))
+		number, ok := c.scheme.Number(c.label)
+		assert.True(t, ok, c.label)
+		assert.Equal(t, c.number, number, c.label)
+	}
+}
+
+func TestLetterLabelsIgnoreCase(t *testing.T) {
+	number, ok := LowerLetterLabels.Number("AB")
+	assert.True(t, ok)
+	assert.Equal(t, 28, number)
+	number, ok = UpperLetterLabels.Number("ab")
+	assert.True(t, ok)
+	assert.Equal(t, 28, number)
+}
+
+func TestBadLabel(t *testing.T) {
+	for _, c := range badLabelCases {
+		_, ok := c.scheme.Number(c.label)
+		assert.False(t, ok, c.label)
+	}
+}
+
+func TestLetterLabelsInitialIndex(t *testing.T) {
+	for _, index := range []int{0, -2} {
+		stdOut := initTest()
+		reader := strings.NewReader("a\r\n")
+		menu := NewMenu("Testing")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.SetLabelScheme(UpperLetterLabels)
+		menu.InitialIndex(index)
+		menu.Action(func(opts []Opt) error {
+			assert.Equal(t, "First", opts[0].Text)
+			return nil
+		})
+		menu.Option("First", nil, false, nil)
+		menu.Option("Second", nil, false, nil)
+		require.NoError(t, menu.Run())
+		assert.Equal(t, "A) First\nB) Second\nTesting\n", stdOut.String())
+	}
+}
+
+func TestLetterLabelsMatchText(t *testing.T) {
+	for _, c := range []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"stag\r\n", "staging", nil},
+		{"b\r\n", "staging", nil},
+		{"prod\r\n", "production", nil},
+		{"St\r\n", "staging", nil},
+		{"a,c\r\n", "development,production", nil},
+		{"d\r\n", "development", nil},
+		{"zz\r\n", "", ErrInvalid},
+	} {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Environment?")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.SetLabelScheme(LowerLetterLabels)
+		menu.MatchText()
+		menu.AllowMultiple()
+		menu.SetSeparator(",")
+		var actual []string
+		menu.Action(func(opts []Opt) error {
+			for _, opt := range opts {
+				actual = append(actual, opt.Text)
+			}
+			return nil
+		})
+		menu.Option("development", nil, false, nil)
+		menu.Option("staging", nil, false, nil)
+		menu.Option("production", nil, false, nil)
+		err := menu.Run()
+		if c.err != nil {
+			if assert.IsType(t, &MenuError{}, err, c.input) {
+				assert.Equal(t, c.err, err.(*MenuError).Err, c.input)
+			}
+		} else {
+			assert.NoError(t, err, c.input)
+		}
+		assert.Equal(t, c.expected, strings.Join(actual, ","), c.input)
+	}
+}
@@ -12,7 +12,6 @@ import (
 	"io"
 	"os"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/dixonwille/wlog/v3"
@@ -37,43 +36,77 @@ var (
 // Menu is used to display options to a user.
 // A user can then select options and Menu will validate the response and perform the correct action.
 type Menu struct {
+	asker
 	question       string
 	function       func([]Opt) error
 	options        []Opt
-	ui             wlog.UI
 	multiSeparator string
 	allowMultiple  bool
-	loopOnInvalid  bool
-	clear          bool
-	tries          int
 	defIcon        string
 	isYN           bool
 	ynDef          DefaultYN
 	padOptionID    bool
 	initialIndex   int
+	matchText      bool
+	suggest        bool
+	allKeyword     string
+	noneKeyword    string
+	excludePrefix  string
+	labels         LabelScheme
+	ynWords        YesNoWords
+	ynPatterns     []*regexp.Regexp
+	ynOptions      [2]Opt
+	ynToAll        bool
+	isChoice       bool
+	minSelections  int
+	maxSelections  int
+	exclusive      [][]string
+	requires       []requirement
+	validator      func([]Opt) error
+	confirm        bool
+	edited         []Opt
+	backKey        string
+	backTitle      string
+	quitKey        string
+	quitTitle      string
+	breadcrumb     bool
+	breadcrumbRoot string
+	disabledColor  wlog.Color
+	crumbColor     wlog.Color
+}
+
+// requirement is an option that can only be selected along with other options.
+type requirement struct {
+	title    string
+	required []string
 }
 
 // NewMenu creates a menu with a wlog.UI as the writer.
 func NewMenu(question string) *Menu {
-	//Create a default ui to use for menu
-	var ui wlog.UI
-	ui = wlog.New(os.Stdin, os.Stdout, os.Stderr)
-	ui = wlog.AddConcurrent(ui)
-
 	return &Menu{
+		asker:          newAsker(),
 		question:       question,
 		function:       nil,
 		options:        nil,
-		ui:             ui,
 		multiSeparator: " ",
 		allowMultiple:  false,
-		loopOnInvalid:  false,
-		clear:          false,
-		tries:          3,
 		defIcon:        "*",
 		isYN:           false,
 		ynDef:          0,
 		initialIndex:   1,
+		allKeyword:     "all",
+		noneKeyword:    "none",
+		excludePrefix:  "!",
+		labels:         NumericLabels,
+		ynWords:        EnglishYesNo,
+		ynPatterns:     EnglishYesNo.compile(false),
+		ynOptions:      [2]Opt{*newOption(0, "y", "yes", false, nil), *newOption(1, "n", "no", false, nil)},
+		backKey:        "<",
+		backTitle:      "Back",
+		quitKey:        ".",
+		quitTitle:      "Quit",
+		disabledColor:  wlog.BrightBlack,
+		crumbColor:     wlog.Cyan,
 	}
 }
 
@@ -82,13 +115,29 @@ func NewMenu(question string) *Menu {
 // questionColor changes the color of the questions.
 // errorColor changes the color of the question.
 // Use wlog.None if you do not want to change the color.
+// Disabled options and the breadcrumb are shown in the colors of SetDisabledColor and SetBreadcrumbColor.
 func (m *Menu) AddColor(optionColor, questionColor, responseColor, errorColor wlog.Color) {
 	if !noColor {
-		m.ui = wlog.AddColor(questionColor, errorColor, wlog.None, wlog.None, optionColor, responseColor, wlog.None, wlog.None, wlog.None, m.ui)
+		m.ui = wlog.AddColor(questionColor, errorColor, m.disabledColor, wlog.None, optionColor, responseColor, wlog.None, m.crumbColor, wlog.None, m.ui)
 	}
 }
 
+// SetDisabledColor sets the color disabled options are shown in by AddColor so they stand out from the other options.
+// Default is wlog.BrightBlack. Use wlog.None to show them like the other options.
+// It has to be called before AddColor.
+func (m *Menu) SetDisabledColor(color wlog.Color) {
+	m.disabledColor = color
+}
+
+// SetBreadcrumbColor sets the color the breadcrumb is shown in by AddColor (see ShowBreadcrumb).
+// Default is wlog.Cyan. Use wlog.None to not color it.
+// It has to be called before AddColor.
+func (m *Menu) SetBreadcrumbColor(color wlog.Color) {
+	m.crumbColor = color
+}
+
 // PadOptionID will pad the option IDs when printing, so they all right-align.
+// This works for any LabelScheme as well as option keys.
 func (m *Menu) PadOptionID() {
 	m.padOptionID = true
 }
@@ -126,18 +175,90 @@ func (m *Menu) SetDefaultIcon(icon string) {
 // IsYesNo sets the menu to a yes/no state.
 // Does not show options but does ask question.
 // Will also parse the answer to allow for all variants of yes/no (IE Y yes No ...)
+// The accepted answers can be changed with SetYesNoWords.
 // Both will call the Action function you specified.
 // Opt{ID: 1, Text: "y"} for yes and Opt{ID: 2, Text: "n"} for no will be passed to the function.
+// Use YesOption and NoOption to change what is passed or to call a function for each answer.
 func (m *Menu) IsYesNo(def DefaultYN) {
 	m.isYN = true
 	m.ynDef = def
 }
 
+// YesOption sets the option that is selected when a yes/no menu is answered with yes.
+// title and value are passed through to the function. Default is "y" and "yes".
+// If function is nil then it will default to the menu's Action.
+func (m *Menu) YesOption(title string, value interface{}, function func(Opt) error) {
+	m.ynOptions[0] = *newOption(0, title, value, false, function)
+}
+
+// NoOption sets the option that is selected when a yes/no menu is answered with no.
+// title and value are passed through to the function. Default is "n" and "no".
+// If function is nil then it will default to the menu's Action.
+func (m *Menu) NoOption(title string, value interface{}, function func(Opt) error) {
+	m.ynOptions[1] = *newOption(1, title, value, false, function)
+}
+
+// SetYesNoWords sets the answers accepted by a yes/no menu and the hint added to its question.
+// Use one of the built in words (IE GermanYesNo) or your own.
+// Default is EnglishYesNo.
+func (m *Menu) SetYesNoWords(words YesNoWords) {
+	m.ynWords = words
+	m.ynPatterns = words.compile(m.ynToAll)
+}
+
+// IsChoice sets the menu to a fixed choice state (IE Overwrite, Skip, Cancel).
+// Options are not listed but are shown on one line after the question (IE [o]verwrite, [s]kip, [C]ancel).
+// Add the choices with OptionKey so each one has its own letter, value and function.
+// The default choice has its key capitalized the same way a yes/no menu does.
+func (m *Menu) IsChoice() {
+	m.isChoice = true
+}
+
 // InitialIndex sets the starting number for the displayed list. It defaults to 1.
+// Letter labels have no zero, so with LowerLetterLabels or UpperLetterLabels an index below 1 starts at 1 (IE a).
 func (m *Menu) InitialIndex(index int) {
 	m.initialIndex = index
 }
 
+// SetLabelScheme sets how options are labeled when printing and when parsing responses.
+// Default is NumericLabels.
+func (m *Menu) SetLabelScheme(scheme LabelScheme) {
+	m.labels = scheme
+}
+
+// SetAllKeyword sets the response that selects every option when multiple selections are allowed.
+// Default value is "all". An empty string turns the keyword off.
+func (m *Menu) SetAllKeyword(keyword string) {
+	m.allKeyword = keyword
+}
+
+// SetNoneKeyword sets the response that selects no options when multiple selections are allowed.
+// Unlike an empty response this does not fall back to the default options.
+// Default value is "none". An empty string turns the keyword off.
+func (m *Menu) SetNoneKeyword(keyword string) {
+	m.noneKeyword = keyword
+}
+
+// SetExcludePrefix sets the prefix used to leave an option out of the selection (IE all !3 !5).
+// Default value is "!". An empty string turns exclusions off.
+func (m *Menu) SetExcludePrefix(prefix string) {
+	m.excludePrefix = prefix
+}
+
+// MatchText allows options to be selected by their text as well as their number.
+// The text is matched without case, either in full or by a prefix that only one option starts with.
+// With letter labels a response that is not the label of a shown option is matched as text (IE stag for staging).
+func (m *Menu) MatchText() {
+	m.matchText = true
+}
+
+// SuggestOnInvalid will suggest the closest options when a response does not match any option.
+// Suggestions are shown in the error with their label (IE did you mean 1) staging?) and stored in MenuError.Suggestions.
+// Disabled options are never suggested.
+func (m *Menu) SuggestOnInvalid() {
+	m.suggest = true
+}
+
 // Option adds an option to the menu for the user to select from.
 // value is an empty interface that can be used to pass anything through to the function.
 // title is the string the user will select
@@ -149,19 +270,168 @@ func (m *Menu) Option(title string, value interface{}, isDefault bool, function
 	m.options = append(m.options, *option)
 }
 
+// DisabledOption adds an option that is shown but can not be selected (IE Deploy to prod).
+// reason is shown next to the option and in the error when it is selected. Use "" for no reason.
+// Disabled options are never default and are left out when all options are selected.
+func (m *Menu) DisabledOption(title string, value interface{}, reason string) {
+	option := newOption(len(m.options), title, value, false, nil)
+	option.isDisabled = true
+	option.reason = reason
+	m.options = append(m.options, *option)
+}
+
+// OptionKey adds an option that is selected with key instead of a number.
+// The key is shown in place of the number when printing (IE q) Quit).
+// An error is returned if key is empty, could be a label of the LabelScheme, is already used by another option,
+// or would be read as the all or none keyword or an exclusion (see SetExcludePrefix).
+// Keys are matched exactly first, then without case if only one key matches.
+// Otherwise this works the same as Option.
+func (m *Menu) OptionKey(key, title string, value interface{}, isDefault bool, function func(Opt) error) error {
+	if err := m.checkKey(key); err != nil {
+		return err
+	}
+	option := newOption(len(m.options), title, value, isDefault, function)
+	option.Key = key
+	m.options = append(m.options, *option)
+	return nil
+}
+
+// Makes sure key can be told apart from the numbers and the other keys of the menu.
+func (m *Menu) checkKey(key string) error {
+	if strings.TrimSpace(key) == "" {
+		return newMenuError(ErrKeyCollision, key, 0)
+	}
+	if _, ok := m.labels.Number(key); ok || m.isKeyword(key) {
+		return newMenuError(ErrKeyCollision, key, 0)
+	}
+	for _, opt := range m.options {
+		if opt.Key == key {
+			return newMenuError(ErrKeyCollision, key, 0)
+		}
+	}
+	return nil
+}
+
+// Makes sure no key collides with a label or keyword in case they changed after keys were added.
+func (m *Menu) checkKeys() error {
+	for _, opt := range m.options {
+		if _, ok := m.labels.Number(opt.Key); opt.Key != "" && (ok || m.isKeyword(opt.Key)) {
+			return newMenuError(ErrKeyCollision, opt.Key, 0)
+		}
+	}
+	return nil
+}
+
+// Makes sure every title of Exclusive and Requires is an option so a typo does not turn the check off.
+func (m *Menu) checkTitles() error {
+	titles := make(map[string]bool)
+	for _, opt := range m.options {
+		titles[opt.Text] = true
+	}
+	for _, group := range m.exclusive {
+		for _, title := range group {
+			if !titles[title] {
+				return newMenuError(ErrUnknownTitle, title, 0)
+			}
+		}
+	}
+	for _, r := range m.requires {
+		for _, title := range append([]string{r.title}, r.required...) {
+			if !titles[title] {
+				return newMenuError(ErrUnknownTitle, title, 0)
+			}
+		}
+	}
+	return nil
+}
+
+// Whether key would be read as the all or none keyword or an exclusion instead of selecting its option.
+func (m *Menu) isKeyword(key string) bool {
+	return (m.allKeyword != "" && strings.EqualFold(key, m.allKeyword)) ||
+		(m.noneKeyword != "" && strings.EqualFold(key, m.noneKeyword)) ||
+		(m.excludePrefix != "" && strings.HasPrefix(key, m.excludePrefix))
+}
+
+// OptionPhrase adds an option that needs phrase typed exactly before its function is called (IE the name of a database being deleted).
+// The user is asked for the phrase after the selection is validated and gets an error of ErrPhraseMismatch if it does not match.
+// Otherwise this works the same as Option.
+func (m *Menu) OptionPhrase(phrase, title string, value interface{}, isDefault bool, function func(Opt) error) {
+	option := newOption(len(m.options), title, value, isDefault, function)
+	option.phrase = phrase
+	m.options = append(m.options, *option)
+}
+
+// OptionOther adds an option that asks question for free text when it is selected (IE Other (please specify)).
+// The answer is put into the Value of the option as a string before any function is called.
+// An empty answer is asked again if LoopOnInvalid is activated and counts against the tries.
+// It can be selected along with other options when multiple selections are allowed, but is never default.
+// Otherwise this works the same as Option.
+func (m *Menu) OptionOther(title, question string, function func(Opt) error) {
+	option := newOption(len(m.options), title, nil, false, function)
+	option.other = question
+	m.options = append(m.options, *option)
+}
+
 // Action adds a default action to use in certain scenarios.
 // If the selected option (by default or user selected) does not have a function applied to it this will be called.
 // If there are no default options and no option was selected this will be called with an option that has an ID of -1.
+// If none was selected on purpose (see SetNoneKeyword) this will be called with no options.
 func (m *Menu) Action(function func([]Opt) error) {
 	m.function = function
 }
 
+// Validator adds a check on the selected options that runs after the menu's own validation and before any function is called.
+// Returning an error is treated like an invalid response, so it counts against the tries and is asked again if LoopOnInvalid is activated.
+// Errors that are not a MenuError are wrapped in one with the response.
+// The default options are checked as well when they are selected with an empty response.
+func (m *Menu) Validator(function func([]Opt) error) {
+	m.validator = function
+}
+
+// ConfirmSelection will ask the user to confirm the selected options before any function is called.
+// The user is shown something like "You selected: A, C, F. Proceed? (y/N/e)".
+// Answering no returns an error of ErrCanceled. Answering e (edit) shows the menu again with the
+// selected options marked by the default icon so the selection can be changed.
+// Other options keep their answer while editing and an empty response keeps the whole selection.
+// The question and answers follow SetYesNoWords (IE GermanYesNo asks "(j/N/b)" with b to edit).
+// Any other answer only asks the confirmation again, keeping the selection, if LoopOnInvalid is activated.
+func (m *Menu) ConfirmSelection() {
+	m.confirm = true
+}
+
 // AllowMultiple will tell the menu to allow multiple selections.
 // The menu will fail if this is not called and mulple selections were selected.
+// Ranges of options can also be selected (IE 1-4 7 9-10).
 func (m *Menu) AllowMultiple() {
 	m.allowMultiple = true
 }
 
+// SetMinSelections sets the fewest options that have to be selected when multiple selections are allowed.
+// Default is 0 which has no minimum.
+func (m *Menu) SetMinSelections(i int) {
+	m.minSelections = i
+}
+
+// SetMaxSelections sets the most options that can be selected when multiple selections are allowed.
+// Default is 0 which has no maximum.
+func (m *Menu) SetMaxSelections(i int) {
+	m.maxSelections = i
+}
+
+// Exclusive sets options that can not be selected together when multiple selections are allowed (IE Postgres and MySQL).
+// Options are matched by their title. Call it once for each group of options.
+// Running the menu returns an error of ErrUnknownTitle if no option has one of the titles.
+func (m *Menu) Exclusive(titles ...string) {
+	m.exclusive = append(m.exclusive, titles)
+}
+
+// Requires sets options that have to be selected whenever the option with title is selected.
+// Options are matched by their title.
+// Running the menu returns an error of ErrUnknownTitle if no option has one of the titles.
+func (m *Menu) Requires(title string, required ...string) {
+	m.requires = append(m.requires, requirement{title: title, required: required})
+}
+
 // ChangeReaderWriter changes where the menu listens and writes to.
 // reader is where user input is collected.
 // writer and errorWriter is where the menu should write to.
@@ -176,8 +446,37 @@ func (m *Menu) ChangeReaderWriter(reader io.Reader, writer, errorWriter io.Write
 // This will validate all responses.
 // Errors are of type MenuError.
 func (m *Menu) Run() error {
+	options, err := m.selectOptions()
+	if err != nil {
+		return err
+	}
+	//step 3 call appropriate action with the responses
+	return m.callAppropriate(options)
+}
+
+// Prints the menu and asks until a valid selection is made or the tries run out.
+// nil options means the default options were selected with an empty response.
+func (m *Menu) selectOptions() ([]Opt, error) {
+	if err := m.checkKeys(); err != nil {
+		return nil, err
+	}
+	if err := m.checkTitles(); err != nil {
+		return nil, err
+	}
+	//Put the tries back so the menu can be ran again
+	defer func(tries int) {
+		m.tries = tries
+	}(m.tries)
 	if m.clear {
-		Clear()
+		m.clearScreen()
+	}
+	if m.confirm {
+		//Editing marks the selection as default so put the real defaults back when done
+		defaults := m.defaults()
+		defer func() {
+			m.markDefaults(defaults)
+			m.edited = nil
+		}()
 	}
 	valid := false
 	var options []Opt
@@ -189,32 +488,69 @@ func (m *Menu) Run() error {
 		m.print()
 		//step 2 ask question, get and validate response
 		opt, err := m.ask()
-		if err != nil {
-			m.tries = m.tries - 1
-			if !IsMenuErr(err) {
-				err = newMenuError(err, "", m.triesLeft())
+		if err == nil && opt == nil && m.edited != nil {
+			//the selection being edited was kept so the answers of the other options are not asked again
+			opt = append([]Opt{}, m.edited...)
+		} else if err == nil {
+			//the tries are counted while asking so an error here is final
+			if err := m.specifyOthers(opt); err != nil {
+				return nil, err
+			}
+		}
+		if err == nil && m.confirm {
+			var edit bool
+			//only the confirmation is asked again for an invalid answer so the selection is kept
+			for {
+				edit, err = m.confirmSelection(opt)
+				if !IsInvalidErr(err) {
+					break
+				}
+				if err = m.failed(err); err != nil {
+					return nil, err
+				}
+			}
+			if IsCanceledErr(err) {
+				return nil, err
 			}
-			if m.loopOnInvalid && m.tries > 0 {
+			if edit {
+				if opt == nil {
+					opt = m.getDefault()
+				}
+				m.edited = opt
+				m.markDefaults(m.selectedIDs(opt))
 				if m.clear {
-					Clear()
+					m.clearScreen()
 				}
-				m.ui.Error(err.Error())
-			} else {
-				return err
+				continue
+			}
+		}
+		if err != nil {
+			if err = m.failed(err); err != nil {
+				return nil, err
 			}
 		} else {
 			options = opt
 			valid = true
 		}
 	}
-	//step 3 call appropriate action with the responses
-	return m.callAppropriate(options)
+	if m.confirm && options == nil {
+		//the defaults may have been changed by editing and are put back before the functions are called
+		options = m.getDefault()
+	}
+	return options, nil
 }
 
 func (m *Menu) callAppropriate(options []Opt) (err error) {
-	if len(options) == 0 {
+	//An empty selection that is not nil means none was selected on purpose
+	if options == nil {
 		return m.callAppropriateNoOptions()
 	}
+	if err := m.confirmPhrases(options); err != nil {
+		return err
+	}
+	if len(options) == 0 {
+		return m.function(options)
+	}
 	if len(options) == 1 && options[0].function != nil {
 		return options[0].function(options[0])
 	}
@@ -226,65 +562,145 @@ func (m *Menu) callAppropriateNoOptions() (err error) {
 	if len(options) == 0 {
 		return m.function([]Opt{{ID: -1}})
 	}
+	if err := m.confirmPhrases(options); err != nil {
+		return err
+	}
 	if len(options) == 1 && options[0].function != nil {
 		return options[0].function(options[0])
 	}
 	return m.function(options)
 }
 
+// Asks for the free text of each selected other option and puts it into the option's Value
+func (m *Menu) specifyOthers(options []Opt) error {
+	for i, opt := range options {
+		if opt.other == "" {
+			continue
+		}
+		for {
+			res, err := m.ui.Ask(opt.other, " ")
+			if err != nil {
+				return err
+			}
+			if res != "" {
+				options[i].Value = res
+				break
+			}
+			if err = m.failed(newMenuError(ErrNoResponse, "", m.triesLeft())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Asks for the phrase of each option that needs one before anything is called
+func (m *Menu) confirmPhrases(options []Opt) error {
+	for _, opt := range options {
+		if opt.phrase == "" {
+			continue
+		}
+		res, err := m.ui.Ask(fmt.Sprintf("Type %q to confirm %s.", opt.phrase, opt.Text), " ")
+		if err != nil {
+			return err
+		}
+		if res != opt.phrase {
+			return newMenuError(ErrPhraseMismatch, res, 0)
+		}
+	}
+	return nil
+}
+
 // hide options when this is a yes or no
+// choices are shown with the question instead
 func (m *Menu) print() {
+	//the header is already at the top when the screen was cleared
+	if m.header != "" && !m.clear {
+		m.ui.Success(m.header)
+	}
+	if m.isChoice {
+		return
+	}
 	if !m.isYN {
-		outputFormat := "%d) %s%s"
-		if m.padOptionID {
-			padding := len(strconv.Itoa(len(m.options)))
-			outputFormat = "%" + strconv.Itoa(padding) + "d) %s%s"
+		labels := make([]string, len(m.options))
+		padding := 0
+		for i := range m.options {
+			labels[i] = m.label(i)
+			if m.padOptionID && len(labels[i]) > padding {
+				padding = len(labels[i])
+			}
 		}
-		for _, opt := range m.options {
+		for i, opt := range m.options {
 			icon := m.defIcon
 			if !opt.isDefault {
 				icon = ""
 			}
-			m.ui.Output(fmt.Sprintf(outputFormat, opt.ID+m.initialIndex, icon, opt.Text))
+			if opt.isDisabled {
+				m.ui.Info(fmt.Sprintf("%*s) %s", padding, labels[i], opt.disabledText()))
+				continue
+			}
+			m.ui.Output(fmt.Sprintf("%*s) %s%s", padding, labels[i], icon, opt.Text))
 		}
 	} else {
-		//TODO Allow user to specify what to use as value for YN options
-		m.options = []Opt{}
-		m.Option("y", "yes", m.ynDef == DefY, nil)
-		m.Option("n", "no", m.ynDef == DefN, nil)
+		yes, no := m.ynOptions[0], m.ynOptions[1]
+		yes.isDefault = m.ynDef == DefY
+		no.isDefault = m.ynDef == DefN
+		m.options = []Opt{yes, no}
+		if m.ynToAll {
+			m.options = append(m.options, *newOption(2, "a", "yes to all", false, nil), *newOption(3, "N", "no to all", false, nil))
+		}
 	}
 }
 
 func (m *Menu) ask() ([]Opt, error) {
+	question := m.question
 	if m.isYN {
 		if m.ynDef == DefY {
-			m.question += " (Y/n)"
+			question += m.ynWords.DefYSuffix
 		} else {
-			m.question += " (y/N)"
+			question += m.ynWords.DefNSuffix
+		}
+		if m.ynToAll {
+			question += m.ynWords.ToAllSuffix
 		}
 	}
+	if m.isChoice {
+		question += " " + m.choiceHint()
+	}
 	var trim string
 	if m.multiSeparator == " " {
 		trim = m.multiSeparator
 	} else {
 		trim = m.multiSeparator + " "
 	}
-	res, err := m.ui.Ask(m.question, trim)
+	res, err := m.ui.Ask(question, trim)
 	if err != nil {
 		return nil, err
 	}
 	//Validate responses
 	//Check if no responses are returned and no action to call
 	if res == "" {
+		//the selection being edited was already validated
+		if m.edited != nil {
+			return nil, nil
+		}
 		//get default options
 		opt := m.getDefault()
 		if !m.validOptAndFunc(opt) {
 			return nil, newMenuError(ErrNoResponse, "", m.triesLeft())
 		}
+		if len(opt) > 0 {
+			if err := m.validateSelected(m.defaultPicks()); err != nil {
+				return nil, err
+			}
+			if err := m.validateSelection(opt, res); err != nil {
+				return nil, err
+			}
+		}
 		return nil, nil
 	}
 
-	var responses []int
+	var responses []pick
 	if !m.isYN {
 		responses, err = m.resToInt(res)
 		if err != nil {
@@ -303,16 +719,144 @@ func (m *Menu) ask() ([]Opt, error) {
 	}
 
 	//Parse responses and return them as options
-	var finalOptions []Opt
+	//This is never nil so selecting none is not mistaken for an empty response
+	finalOptions := make([]Opt, 0, len(responses))
 	for _, response := range responses {
-		finalOptions = append(finalOptions, m.options[response-m.initialIndex])
+		finalOptions = append(finalOptions, m.options[response.index])
+	}
+	if len(finalOptions) == 0 && m.function == nil {
+		return nil, newMenuError(ErrNoResponse, res, m.triesLeft())
+	}
+	if err := m.validateSelection(finalOptions, res); err != nil {
+		return nil, err
 	}
 
 	return finalOptions, nil
 }
 
-// Converts the response string to a slice of ints, also validates along the way.
-func (m *Menu) resToInt(res string) ([]int, error) {
+// Asks the user to confirm the selected options
+// edit is true if the user wants to change the selection
+func (m *Menu) confirmSelection(options []Opt) (edit bool, err error) {
+	if options == nil {
+		options = m.getDefault()
+	}
+	question, suffix, none := m.ynWords.Confirm, m.ynWords.ConfirmSuffix, m.ynWords.None
+	if question == "" {
+		question = EnglishYesNo.Confirm
+	}
+	if suffix == "" {
+		suffix = m.ynWords.DefNSuffix
+	}
+	if none == "" {
+		none = EnglishYesNo.None
+	}
+	texts := []string{none}
+	if len(options) > 0 {
+		texts = nil
+		for _, opt := range options {
+			texts = append(texts, opt.Text)
+		}
+	}
+	res, err := m.ui.Ask(fmt.Sprintf(question, strings.Join(texts, ", "))+suffix, " ")
+	if err != nil {
+		return false, err
+	}
+	if res == "" {
+		return false, newMenuError(ErrCanceled, "", m.triesLeft())
+	}
+	for _, word := range m.ynWords.Edit {
+		if strings.EqualFold(res, word) {
+			return true, nil
+		}
+	}
+	for _, re := range m.ynPatterns {
+		matches := re.FindStringSubmatch(res)
+		if len(matches) > 2 && matches[1] != "" {
+			return false, nil
+		}
+		if len(matches) > 2 && matches[2] != "" {
+			return false, newMenuError(ErrCanceled, res, m.triesLeft())
+		}
+	}
+	return false, newMenuError(ErrInvalid, res, m.triesLeft())
+}
+
+// Gets the IDs of the selected options, or of the default options if nothing was entered
+func (m *Menu) selectedIDs(options []Opt) []int {
+	if options == nil {
+		return m.defaults()
+	}
+	var ids []int
+	for _, opt := range options {
+		ids = append(ids, opt.ID)
+	}
+	return ids
+}
+
+// Gets the default options as picks so they can be validated like a response
+func (m *Menu) defaultPicks() []pick {
+	var picks []pick
+	for i, o := range m.options {
+		if o.isDefault && !o.isDisabled {
+			picks = append(picks, pick{index: i, res: o.Text})
+		}
+	}
+	return picks
+}
+
+// Gets the IDs of the default options
+func (m *Menu) defaults() []int {
+	var ids []int
+	for _, opt := range m.getDefault() {
+		ids = append(ids, opt.ID)
+	}
+	return ids
+}
+
+// Marks only the options with the given IDs as default
+// Other options are never default as their answer is only known once they are selected
+func (m *Menu) markDefaults(ids []int) {
+	for i := range m.options {
+		m.options[i].isDefault = m.options[i].other == "" && exist(ids, m.options[i].ID)
+	}
+}
+
+// Builds the line of choices shown after the question (IE [o]verwrite, [s]kip, [C]ancel).
+// The key is bracketed where it is found in the text, otherwise it is put in front of the text.
+func (m *Menu) choiceHint() string {
+	var choices []string
+	for i, opt := range m.options {
+		label := m.label(i)
+		if opt.isDefault {
+			label = strings.ToUpper(label)
+		}
+		text := strings.ToLower(opt.Text)
+		at := strings.Index(text, strings.ToLower(label))
+		//Lowering the case can change the length of some text
+		if at < 0 || len(text) != len(opt.Text) {
+			choices = append(choices, "["+label+"] "+opt.Text)
+			continue
+		}
+		choices = append(choices, opt.Text[:at]+"["+label+"]"+opt.Text[at+len(label):])
+	}
+	return strings.Join(choices, ", ")
+}
+
+// pick is a single option chosen by the user along with the part of the response it came from.
+type pick struct {
+	index int
+	res   string
+}
+
+// Converts the response string to a slice of picks, also validates along the way.
+// Ranges such as 3-8 are expanded when multiple responses are allowed.
+func (m *Menu) resToInt(res string) ([]pick, error) {
+	//The whole response could be the text of an option that contains the separator
+	if m.matchText {
+		if i, ok := m.findText(res); ok {
+			return []pick{{index: i, res: res}}, nil
+		}
+	}
 	resStrings := strings.Split(res, m.multiSeparator)
 	//Check if we don't want multiple responses
 	if !m.allowMultiple && len(resStrings) > 1 {
@@ -320,54 +864,370 @@ func (m *Menu) resToInt(res string) ([]int, error) {
 	}
 
 	//Convert responses to intigers
-	var responses []int
+	var responses, excluded []pick
+	var all, none string
 	for _, response := range resStrings {
-		//Check if it is an intiger
 		response = strings.Trim(response, " ")
-		r, err := strconv.Atoi(response)
+		if m.allowMultiple {
+			switch {
+			case m.allKeyword != "" && strings.EqualFold(response, m.allKeyword):
+				if all != "" {
+					return nil, newMenuError(ErrDuplicate, response, m.triesLeft())
+				}
+				all = response
+				continue
+			case m.noneKeyword != "" && strings.EqualFold(response, m.noneKeyword):
+				none = response
+				continue
+			case m.excludePrefix != "" && strings.HasPrefix(response, m.excludePrefix):
+				picks, err := m.parseResponse(strings.TrimPrefix(response, m.excludePrefix))
+				if err != nil {
+					return nil, err
+				}
+				excluded = append(excluded, picks...)
+				continue
+			}
+		}
+		picks, err := m.parseResponse(response)
 		if err != nil {
-			return nil, newMenuError(ErrInvalid, response, m.triesLeft())
+			return nil, err
+		}
+		responses = append(responses, picks...)
+	}
+
+	//None has to be the only response so the selection is really empty
+	if none != "" {
+		if len(resStrings) > 1 {
+			return nil, newMenuError(ErrInvalid, none, m.triesLeft())
+		}
+		return []pick{}, nil
+	}
+	if all != "" {
+		var allPicks []pick
+		for i, opt := range m.options {
+			if !opt.isDisabled && !opt.navigates() {
+				allPicks = append(allPicks, pick{index: i, res: all})
+			}
 		}
-		responses = append(responses, r)
+		responses = append(allPicks, responses...)
+	}
+	if len(excluded) > 0 {
+		err := m.validatePicks(excluded)
+		if err != nil {
+			return nil, err
+		}
+		var kept []pick
+		for _, response := range responses {
+			if !isPicked(excluded, response.index) {
+				kept = append(kept, response)
+			}
+		}
+		if len(kept) == 0 {
+			return nil, newMenuError(ErrNoResponse, "", m.triesLeft())
+		}
+		responses = kept
 	}
 	return responses, nil
 }
 
-func (m *Menu) ynResParse(res string) ([]int, error) {
+// Converts a single response to the picks it stands for.
+// It can be an option's key, a number, a range of numbers or the text of an option.
+func (m *Menu) parseResponse(response string) ([]pick, error) {
+	if i, ok := m.findKey(response); ok {
+		return []pick{{index: i, res: response}}, nil
+	}
+	if m.allowMultiple {
+		start, end, isRange, err := m.parseRange(response)
+		if err != nil {
+			return nil, err
+		}
+		if isRange {
+			var picks []pick
+			for r := start; r <= end; r++ {
+				i, _ := m.numberToIndex(r)
+				picks = append(picks, pick{index: i, res: response})
+			}
+			return picks, nil
+		}
+	}
+	//Check if it is a label
+	r, isLabel := m.labels.Number(response)
+	if isLabel {
+		if i, ok := m.numberToIndex(r); ok {
+			return []pick{{index: i, res: response}}, nil
+		}
+	}
+	//Letter labels can be any word so one that is not shown can still be text (IE stag for staging)
+	if !m.matchText {
+		if isLabel {
+			return nil, newMenuError(ErrInvalid, response, m.triesLeft())
+		}
+		return nil, m.invalidError(response)
+	}
+	i, err := m.matchOption(response)
+	if err != nil {
+		return nil, err
+	}
+	return []pick{{index: i, res: response}}, nil
+}
+
+// Finds the option with the given key.
+// An exact match wins, otherwise case is ignored as long as only one key matches.
+func (m *Menu) findKey(response string) (int, bool) {
+	found := -1
+	for i, opt := range m.options {
+		if opt.Key == "" {
+			continue
+		}
+		if opt.Key == response {
+			return i, true
+		}
+		if strings.EqualFold(opt.Key, response) {
+			if found >= 0 {
+				return 0, false
+			}
+			found = i
+		}
+	}
+	return found, found >= 0
+}
+
+// Finds the option shown with the given number.
+// Options with a key are not numbered so they are skipped.
+func (m *Menu) numberToIndex(number int) (int, bool) {
+	n := number - m.firstNumber()
+	if n < 0 {
+		return 0, false
+	}
+	for i, opt := range m.options {
+		if opt.Key != "" {
+			continue
+		}
+		if n == 0 {
+			return i, true
+		}
+		n--
+	}
+	return 0, false
+}
+
+// Gets the number of the first option without a key.
+// Letter labels can not show a number below 1 so they start at 1 at the least.
+func (m *Menu) firstNumber() int {
+	if _, ok := m.labels.(letterLabels); ok && m.initialIndex < 1 {
+		return 1
+	}
+	return m.initialIndex
+}
+
+// Gets the label shown for the option at index i.
+// This is the option's key if it has one, otherwise it is the option's number.
+func (m *Menu) label(i int) string {
+	if m.options[i].Key != "" {
+		return m.options[i].Key
+	}
+	number := m.firstNumber()
+	for _, opt := range m.options[:i] {
+		if opt.Key == "" {
+			number++
+		}
+	}
+	return m.labels.Label(number)
+}
+
+// Finds the option whose text is exactly the response, ignoring case.
+func (m *Menu) findText(response string) (int, bool) {
+	for i, opt := range m.options {
+		if strings.EqualFold(opt.Text, response) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Finds the option whose text matches the response in full or by a unique prefix.
+func (m *Menu) matchOption(response string) (int, error) {
+	if response == "" {
+		return 0, newMenuError(ErrInvalid, response, m.triesLeft())
+	}
+	if i, ok := m.findText(response); ok {
+		return i, nil
+	}
+	var matches []int
+	lower := strings.ToLower(response)
+	for i, opt := range m.options {
+		if strings.HasPrefix(strings.ToLower(opt.Text), lower) {
+			matches = append(matches, i)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return 0, m.invalidError(response)
+	case 1:
+		return matches[0], nil
+	}
+	err := newMenuError(ErrAmbiguous, response, m.triesLeft())
+	for _, i := range matches {
+		err.Matches = append(err.Matches, m.options[i].Text)
+	}
+	return 0, err
+}
+
+// Splits a response like 3-8 (or c-h) into the numbers it starts and ends with.
+// isRange is false if the response does not look like a range.
+// Bounds are checked here so a huge range is never expanded.
+func (m *Menu) parseRange(response string) (start, end int, isRange bool, err error) {
+	//A leading dash is a negative number and not a range
+	i := strings.Index(response, "-")
+	if i < 1 {
+		return 0, 0, false, nil
+	}
+	start, startLabel := m.labels.Number(strings.Trim(response[:i], " "))
+	end, endLabel := m.labels.Number(strings.Trim(response[i+1:], " "))
+	//Not a range of labels so it can still be matched to an option's text (IE x-ray)
+	if !startLabel || !endLabel {
+		return 0, 0, false, nil
+	}
+	_, startOk := m.numberToIndex(start)
+	_, endOk := m.numberToIndex(end)
+	if start > end || !startOk || !endOk {
+		return 0, 0, true, newMenuError(ErrInvalid, response, m.triesLeft())
+	}
+	return start, end, true, nil
+}
+
+// Creates an invalid response error along with suggestions if they are turned on.
+// Suggestions are shown with their label as the text can only be typed when MatchText is activated.
+func (m *Menu) invalidError(response string) *MenuError {
+	err := newMenuError(ErrInvalid, response, m.triesLeft())
+	if m.suggest {
+		for _, i := range suggest(response, m.options) {
+			err.Suggestions = append(err.Suggestions, m.label(i)+") "+m.options[i].Text)
+		}
+	}
+	return err
+}
+
+func (m *Menu) ynResParse(res string) ([]pick, error) {
 	resStrings := strings.Split(res, m.multiSeparator)
 	if len(resStrings) > 1 {
 		return nil, newMenuError(ErrTooMany, "", m.triesLeft())
 	}
-	re := regexp.MustCompile(`^\s*(?:([Yy])(?:es|ES)?|([Nn])(?:o|O)?)\s*$`)
-	matches := re.FindStringSubmatch(res)
-	if len(matches) < 2 {
-		return nil, newMenuError(ErrInvalid, res, m.triesLeft())
+	for _, re := range m.ynPatterns {
+		matches := re.FindStringSubmatch(res)
+		//The group that matched is the index of the option
+		for i := 1; i < len(matches); i++ {
+			if matches[i] != "" {
+				return []pick{{index: i - 1, res: res}}, nil
+			}
+		}
+	}
+	return nil, newMenuError(ErrInvalid, res, m.triesLeft())
+}
+
+// Check the responses are valid picks and that enough but not too many were selected
+func (m *Menu) validateResponses(responses []pick) error {
+	err := m.validatePicks(responses)
+	if err != nil {
+		return err
+	}
+	for _, response := range responses {
+		if opt := m.options[response.index]; opt.isDisabled {
+			err := newMenuError(ErrDisabled, response.res, m.triesLeft())
+			err.Reason = opt.reason
+			return err
+		}
+	}
+	return m.validateSelected(responses)
+}
+
+// Check that enough but not too many options were selected and that the groups of options are followed.
+// The default options are checked with this as well when they are selected with an empty response.
+func (m *Menu) validateSelected(responses []pick) error {
+	if len(responses) > 1 {
+		for _, response := range responses {
+			if m.options[response.index].navigates() {
+				return newMenuError(ErrTooMany, response.res, m.triesLeft())
+			}
+		}
+	}
+	//The limits only apply to multiple selections as otherwise one option is always selected
+	if m.allowMultiple && len(responses) < m.minSelections {
+		return newMenuError(ErrTooFew, fmt.Sprintf("%d of at least %d", len(responses), m.minSelections), m.triesLeft())
+	}
+	if m.allowMultiple && m.maxSelections > 0 && len(responses) > m.maxSelections {
+		return newMenuError(ErrOverMax, fmt.Sprintf("%d of at most %d", len(responses), m.maxSelections), m.triesLeft())
+	}
+	return m.validateGroups(responses)
+}
+
+// Check that no exclusive options were selected together and every requirement was selected
+func (m *Menu) validateGroups(responses []pick) error {
+	selected := make(map[string]bool)
+	for _, response := range responses {
+		selected[m.options[response.index].Text] = true
+	}
+	for _, group := range m.exclusive {
+		var conflicts []string
+		for _, title := range group {
+			if selected[title] {
+				conflicts = append(conflicts, title)
+			}
+		}
+		if len(conflicts) > 1 {
+			return newMenuError(ErrConflict, strings.Join(conflicts, ", "), m.triesLeft())
+		}
 	}
-	if strings.ToLower(matches[1]) == "y" {
-		return []int{int(DefY)}, nil
+	for _, r := range m.requires {
+		if !selected[r.title] {
+			continue
+		}
+		var missing []string
+		for _, title := range r.required {
+			if !selected[title] {
+				missing = append(missing, title)
+			}
+		}
+		if len(missing) > 0 {
+			err := newMenuError(ErrMissingRequired, r.title, m.triesLeft())
+			err.Reason = "requires " + strings.Join(missing, ", ")
+			return err
+		}
 	}
-	return []int{int(DefN)}, nil
+	return nil
 }
 
 // Check if response is in the range of options
 // If it is make sure it is not duplicated
-func (m *Menu) validateResponses(responses []int) error {
+func (m *Menu) validatePicks(responses []pick) error {
 	var tmp []int
 	for _, response := range responses {
-		realIndex := response - m.initialIndex
-		if realIndex < 0 || len(m.options) <= realIndex {
-			return newMenuError(ErrInvalid, strconv.Itoa(response), m.triesLeft())
+		if response.index < 0 || len(m.options) <= response.index {
+			return newMenuError(ErrInvalid, response.res, m.triesLeft())
 		}
 
-		if exist(tmp, response) {
-			return newMenuError(ErrDuplicate, strconv.Itoa(response), m.triesLeft())
+		if exist(tmp, response.index) {
+			return newMenuError(ErrDuplicate, response.res, m.triesLeft())
 		}
 
-		tmp = append(tmp, response)
+		tmp = append(tmp, response.index)
 	}
 	return nil
 }
 
+// Runs the menu's Validator on the selected options
+// Errors that are not a MenuError are wrapped in one with the response
+func (m *Menu) validateSelection(options []Opt, res string) error {
+	if m.validator == nil {
+		return nil
+	}
+	err := m.validator(options)
+	if err != nil && !IsMenuErr(err) {
+		return newMenuError(err, res, m.triesLeft())
+	}
+	return err
+}
+
 // Simply checks if number exists in the slice
 func exist(slice []int, number int) bool {
 	for _, s := range slice {
@@ -378,11 +1238,21 @@ func exist(slice []int, number int) bool {
 	return false
 }
 
+// Simply checks if the option at index was picked
+func isPicked(picks []pick, index int) bool {
+	for _, p := range picks {
+		if index == p.index {
+			return true
+		}
+	}
+	return false
+}
+
 // gets a list of default options
 func (m *Menu) getDefault() []Opt {
 	var opt []Opt
 	for _, o := range m.options {
-		if o.isDefault {
+		if o.isDefault && !o.isDisabled {
 			opt = append(opt, o)
 		}
 	}
@@ -400,10 +1270,3 @@ func (m *Menu) validOptAndFunc(opt []Opt) bool {
 	}
 	return true
 }
-
-func (m *Menu) triesLeft() int {
-	if m.loopOnInvalid && m.tries > 0 {
-		return m.tries
-	}
-	return 0
-}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/dixonwille/wlog/v3"
 	"github.com/stretchr/testify/assert"
@@ -82,6 +83,67 @@ var ynCases = []struct {
 	{"boo ahh\r\n", func(opts []Opt) error { return errors.New(opts[0].Text) }, "too many responses", DefY},
 }
 
+var selectionCases = []struct {
+	input    string
+	min      int
+	max      int
+	expected string
+}{
+	{"1 2\r\n", 2, 3, "A B"},
+	{"1-3\r\n", 2, 3, "A B C"},
+	{"1\r\n", 2, 3, "too few responses: 1 of at least 2"},
+	{"none\r\n", 1, 0, "too few responses: 0 of at least 1"},
+	{"all\r\n", 2, 3, "more than the maximum responses: 4 of at most 3"},
+	{"1-4\r\n", 0, 0, "A B C D"},
+	{"\r\n", 2, 3, "too few responses: 1 of at least 2"},
+	{"\r\n", 1, 1, "A"},
+}
+
+var disabledCases = []struct {
+	input    string
+	expected string
+}{
+	{"1\r\n", "Deploy to dev"},
+	{"2\r\n", "option disabled: 2 (requires VPN)"},
+	{"3\r\n", "option disabled: 3"},
+	{"1-2\r\n", "option disabled: 1-2 (requires VPN)"},
+	{"all\r\n", "Deploy to dev Deploy to staging"},
+	{"\r\n", "Deploy to dev"},
+}
+
+var groupCases = []struct {
+	input    string
+	expected string
+}{
+	{"1 3\r\n", "Postgres Django"},
+	{"1 2\r\n", "conflicting responses: Postgres, MySQL"},
+	{"2 4 1\r\n", "conflicting responses: Postgres, MySQL"},
+	{"3\r\n", "missing required response: Django (requires Postgres)"},
+	{"2 3\r\n", "missing required response: Django (requires Postgres)"},
+	{"5\r\n", "missing required response: Celery (requires Django, Redis)"},
+	{"1 3 5\r\n", "missing required response: Celery (requires Redis)"},
+	{"1 3 4 5\r\n", "Postgres Django Redis Celery"},
+}
+
+var ynWordsCases = []struct {
+	input    string
+	words    YesNoWords
+	expected string
+}{
+	{"ja\r\n", GermanYesNo, "y"},
+	{"J\r\n", GermanYesNo, "y"},
+	{"Nein\r\n", GermanYesNo, "n"},
+	{"yes\r\n", GermanYesNo, "invalid response: yes"},
+	{"sí\r\n", SpanishYesNo, "y"},
+	{"SÍ\r\n", SpanishYesNo, "y"},
+	{"si\r\n", SpanishYesNo, "y"},
+	{"no\r\n", SpanishYesNo, "n"},
+	{"oui\r\n", FrenchYesNo, "y"},
+	{"da\r\n", YesNoWords{Yes: []string{"da", "d"}, No: []string{"nyet"}}, "y"},
+	{"n\r\n", YesNoWords{Yes: []string{"da", "d"}, No: []string{"nyet"}}, "invalid response: n"},
+	{"y\r\n", YesNoWords{No: []string{"n"}}, "invalid response: y"},
+}
+
 var trimCases = []struct {
 	input    string
 	del      string
@@ -98,6 +160,78 @@ var trimCases = []struct {
 	{"1, 2, \r\n", ",", "1 2\r\n"},
 }
 
+var rangeCases = []struct {
+	input    string
+	index    int
+	expected string
+}{
+	{"1-3\r\n", 1, "A B C"},
+	{"1-2 4-5\r\n", 1, "A B D E"},
+	{"5 1-2\r\n", 1, "E A B"},
+	{"3-3\r\n", 1, "C"},
+	{"0-2\r\n", 0, "A B C"},
+	{"1-3 2\r\n", 1, "duplicated response: 2"},
+	{"2 1-3\r\n", 1, "duplicated response: 1-3"},
+	{"4-6\r\n", 1, "invalid response: 4-6"},
+	{"3-1\r\n", 1, "invalid response: 3-1"},
+	{"1-a\r\n", 1, "invalid response: 1-a"},
+}
+
+var textCases = []struct {
+	input    string
+	multiple bool
+	expected string
+}{
+	{"staging\r\n", false, "staging"},
+	{"PROD\r\n", false, "prod"},
+	{"d\r\n", false, "dev"},
+	{"2\r\n", false, "prod"},
+	{"stag\r\n", false, "staging"},
+	{"st\r\n", false, "ambiguous response: st (staging, stable)"},
+	{"qa\r\n", false, "invalid response: qa"},
+	{"dev stable\r\n", false, "too many responses"},
+	{"dev 1\r\n", true, "dev staging"},
+	{"dev de\r\n", true, "duplicated response: de"},
+	{"Blue Green\r\n", true, "Blue Green"},
+	{"x-ray prod\r\n", true, "x-ray prod"},
+	{"x-r\r\n", true, "x-ray"},
+	{"1-2\r\n", true, "staging prod"},
+}
+
+var keywordCases = []struct {
+	input    string
+	disable  bool
+	expected string
+}{
+	{"all\r\n", false, "A B C D"},
+	{"ALL\r\n", false, "A B C D"},
+	{"all !2 !4\r\n", false, "A C"},
+	{"all !2-3\r\n", false, "A D"},
+	{"1 2 3 !2\r\n", false, "A C"},
+	{"none\r\n", false, "none selected"},
+	{"none 1\r\n", false, "invalid response: none"},
+	{"all 2\r\n", false, "duplicated response: 2"},
+	{"all all\r\n", false, "duplicated response: all"},
+	{"all !5\r\n", false, "invalid response: 5"},
+	{"all !1-4\r\n", false, "no response"},
+	{"all\r\n", true, "invalid response: all"},
+	{"none\r\n", true, "invalid response: none"},
+	{"!1\r\n", true, "invalid response: !1"},
+}
+
+var keyCases = []struct {
+	input    string
+	expected string
+}{
+	{"1\r\n", "Save"},
+	{"2\r\n", "Load"},
+	{"q\r\n", "Quit"},
+	{"Q\r\n", "Quit"},
+	{"b\r\n", "back"},
+	{"B\r\n", "Back"},
+	{"3\r\n", "invalid response: 3"},
+}
+
 func init() {
 	// Terminal is not set in many CI environments
 	if os.Getenv("TERMINAL") == "" {
@@ -423,6 +557,31 @@ func Example_errorDuplicate() {
 
 }
 
+func Example_choice() {
+	reader := strings.NewReader("s\r\n") // Simulates the user typing "s" and hitting the [enter] key
+	menu := NewMenu("config.yaml already exists.")
+	menu.ChangeReaderWriter(reader, os.Stdout, os.Stderr)
+	menu.IsChoice()
+	_ = menu.OptionKey("o", "Overwrite", "overwrite", false, nil)
+	_ = menu.OptionKey("s", "Skip", "skip", false, func(opt Opt) error {
+		fmt.Println("Skipping config.yaml.")
+		return nil
+	})
+	_ = menu.OptionKey("c", "Cancel", "cancel", true, nil)
+	menu.Action(func(opts []Opt) error {
+		fmt.Printf("Chose to %s.\n", opts[0].Value)
+		return nil
+	})
+
+	err := menu.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+	// Output:
+	// config.yaml already exists. [o]verwrite, [s]kip, [C]ancel
+	// Skipping config.yaml.
+}
+
 func TestNewMenu(t *testing.T) {
 	assert := assert.New(t)
 	for _, c := range newMenuCases {
@@ -437,6 +596,10 @@ func TestNewMenu(t *testing.T) {
 		assert.NotNil(menu.ui)
 		assert.Equal(3, menu.tries)
 		assert.Equal(1, menu.initialIndex)
+		assert.Equal("all", menu.allKeyword)
+		assert.Equal("none", menu.noneKeyword)
+		assert.Equal("!", menu.excludePrefix)
+		assert.Len(menu.ynPatterns, 2)
 	}
 }
 
@@ -512,6 +675,16 @@ func TestAddColor(t *testing.T) {
 	}
 }
 
+func TestSetColors(t *testing.T) {
+	menu := NewMenu("Testing")
+	assert.Equal(t, wlog.BrightBlack, menu.disabledColor)
+	assert.Equal(t, wlog.Cyan, menu.crumbColor)
+	menu.SetDisabledColor(wlog.None)
+	menu.SetBreadcrumbColor(wlog.Yellow)
+	assert.Equal(t, wlog.None, menu.disabledColor)
+	assert.Equal(t, wlog.Yellow, menu.crumbColor)
+}
+
 func TestSetDefaultIcon(t *testing.T) {
 	menu := NewMenu("Testing")
 	for _, c := range defaultIconCases {
@@ -737,6 +910,104 @@ func TestYesNo(t *testing.T) {
 	}
 }
 
+func TestYesNoWords(t *testing.T) {
+	for _, c := range ynWordsCases {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Yes or No")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.IsYesNo(DefY)
+		menu.SetYesNoWords(c.words)
+		menu.Action(func(opts []Opt) error { return errors.New(opts[0].Text) })
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+	}
+}
+
+func TestYesNoSuffix(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("x\r\nj\r\n"))
+	menu := NewMenu("Weiter?")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.IsYesNo(DefN)
+	menu.SetYesNoWords(GermanYesNo)
+	menu.LoopOnInvalid()
+	menu.Action(func(opts []Opt) error { return nil })
+	err := menu.Run()
+	require.NoError(t, err)
+	assert.Equal(t, "Weiter? (j/N)\ninvalid response: x\nWeiter? (j/N)\n", stdOut.String())
+}
+
+func TestYesNoOptions(t *testing.T) {
+	type answer int
+	const (
+		confirmed answer = iota + 1
+		declined
+	)
+	for _, c := range []struct {
+		input    string
+		expected string
+	}{
+		{"y\r\n", "Yes: true"},
+		{"no\r\n", "Nope: false"},
+		{"\r\n", "Nope: false"},
+	} {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Continue?")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.IsYesNo(DefN)
+		menu.YesOption("Yes", true, nil)
+		menu.NoOption("Nope", false, nil)
+		menu.Action(func(opts []Opt) error {
+			return fmt.Errorf("%s: %v", opts[0].Text, opts[0].Value.(bool))
+		})
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error())
+	}
+
+	var got answer
+	stdOut := initTest()
+	reader := strings.NewReader("yes\r\n")
+	menu := NewMenu("Continue?")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.IsYesNo(DefY)
+	menu.YesOption("y", confirmed, func(opt Opt) error { got = opt.Value.(answer); return nil })
+	menu.NoOption("n", declined, func(opt Opt) error { got = opt.Value.(answer); return nil })
+	require.NoError(t, menu.Run())
+	assert.Equal(t, confirmed, got)
+}
+
+func TestChoice(t *testing.T) {
+	for _, c := range []struct {
+		input    string
+		expected string
+	}{
+		{"a\r\n", "abort"},
+		{"R\r\n", "retry"},
+		{"\r\n", "retry"},
+		{"x\r\n", "ignore"},
+		{"1\r\n", "invalid response: 1"},
+		{"a r\r\n", "too many responses"},
+	} {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Drive not ready.")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.IsChoice()
+		require.NoError(t, menu.OptionKey("a", "Abort", "abort", false, nil))
+		require.NoError(t, menu.OptionKey("r", "Retry", "retry", true, nil))
+		require.NoError(t, menu.OptionKey("x", "Ignore", "ignore", false, nil))
+		menu.Action(func(opts []Opt) error { return errors.New(opts[0].Value.(string)) })
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+		assert.Equal(t, "Drive not ready. [a]bort, [R]etry, [x] Ignore\n", stdOut.String())
+	}
+}
+
 func TestIDPadding(t *testing.T) {
 	stdOut := initTest()
 	reader := strings.NewReader("2")
@@ -792,6 +1063,803 @@ func TestTrim(t *testing.T) {
 	}
 }
 
+func TestRange(t *testing.T) {
+	for _, c := range rangeCases {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Testing")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		menu.InitialIndex(c.index)
+		menu.Action(func(opts []Opt) error {
+			var actual []string
+			for _, opt := range opts {
+				actual = append(actual, opt.Text)
+			}
+			return errors.New(strings.Join(actual, " "))
+		})
+		menu.Option("A", nil, false, nil)
+		menu.Option("B", nil, false, nil)
+		menu.Option("C", nil, false, nil)
+		menu.Option("D", nil, false, nil)
+		menu.Option("E", nil, false, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error())
+	}
+}
+
+func TestRangeNotAllowed(t *testing.T) {
+	stdOut := initTest()
+	reader := strings.NewReader("1-2\r\n")
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.Option("A", nil, false, nil)
+	menu.Option("B", nil, false, nil)
+	err := menu.Run()
+	require.True(t, IsInvalidErr(err))
+	assert.Equal(t, "1-2", err.(*MenuError).Res)
+}
+
+func TestMatchText(t *testing.T) {
+	for _, c := range textCases {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Testing")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.MatchText()
+		if c.multiple {
+			menu.AllowMultiple()
+		}
+		menu.Action(func(opts []Opt) error {
+			var actual []string
+			for _, opt := range opts {
+				actual = append(actual, opt.Text)
+			}
+			return errors.New(strings.Join(actual, " "))
+		})
+		menu.Option("staging", nil, false, nil)
+		menu.Option("prod", nil, false, nil)
+		menu.Option("dev", nil, false, nil)
+		menu.Option("stable", nil, false, nil)
+		menu.Option("Blue Green", nil, false, nil)
+		menu.Option("x-ray", nil, false, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error())
+	}
+}
+
+func TestSuggestOnInvalid(t *testing.T) {
+	stdOut := initTest()
+	stdErr := initTest()
+	// One byte at a time so each ask only reads its own line
+	reader := iotest.OneByteReader(strings.NewReader("stagnig\r\n1\r\n"))
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdErr)
+	menu.SuggestOnInvalid()
+	menu.LoopOnInvalid()
+	menu.Action(func(opts []Opt) error {
+		assert.Equal(t, "staging", opts[0].Text)
+		return nil
+	})
+	menu.Option("staging", nil, false, nil)
+	menu.Option("prod", nil, false, nil)
+	err := menu.Run()
+	require.NoError(t, err)
+	assert.Equal(t, "invalid response: stagnig (did you mean 1) staging?)\n", stdErr.String())
+}
+
+func TestSuggestWithoutMatch(t *testing.T) {
+	stdOut := initTest()
+	reader := strings.NewReader("qa\r\n")
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.SuggestOnInvalid()
+	menu.Option("staging", nil, false, nil)
+	menu.Option("prod", nil, false, nil)
+	err := menu.Run()
+	require.True(t, IsInvalidErr(err))
+	assert.Empty(t, err.(*MenuError).Suggestions)
+}
+
+func TestKeywords(t *testing.T) {
+	for _, c := range keywordCases {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Testing")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		if c.disable {
+			menu.SetAllKeyword("")
+			menu.SetNoneKeyword("")
+			menu.SetExcludePrefix("")
+		}
+		menu.Action(func(opts []Opt) error {
+			if len(opts) == 0 {
+				return errors.New("none selected")
+			}
+			var actual []string
+			for _, opt := range opts {
+				actual = append(actual, opt.Text)
+			}
+			return errors.New(strings.Join(actual, " "))
+		})
+		menu.Option("A", nil, true, nil)
+		menu.Option("B", nil, false, nil)
+		menu.Option("C", nil, false, nil)
+		menu.Option("D", nil, false, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+	}
+}
+
+func TestSelectionBounds(t *testing.T) {
+	for _, c := range selectionCases {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Testing")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		menu.SetMinSelections(c.min)
+		menu.SetMaxSelections(c.max)
+		menu.Action(func(opts []Opt) error {
+			var actual []string
+			for _, opt := range opts {
+				actual = append(actual, opt.Text)
+			}
+			return errors.New(strings.Join(actual, " "))
+		})
+		menu.Option("A", nil, true, nil)
+		menu.Option("B", nil, false, nil)
+		menu.Option("C", nil, false, nil)
+		menu.Option("D", nil, false, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+	}
+}
+
+func TestSelectionBoundsSingle(t *testing.T) {
+	stdOut := initTest()
+	reader := strings.NewReader("2\r\n")
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.SetMinSelections(2)
+	menu.SetMaxSelections(3)
+	menu.Action(func(opts []Opt) error {
+		return errors.New(opts[0].Text)
+	})
+	menu.Option("A", nil, false, nil)
+	menu.Option("B", nil, false, nil)
+	err := menu.Run()
+	require.Error(t, err)
+	assert.Equal(t, "B", err.Error())
+}
+
+func TestSelectionBoundsLoop(t *testing.T) {
+	stdOut := initTest()
+	stdErr := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("1\r\n1 2 3\r\n"))
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdErr)
+	menu.AllowMultiple()
+	menu.LoopOnInvalid()
+	menu.SetTries(2)
+	menu.SetMinSelections(2)
+	menu.SetMaxSelections(2)
+	menu.Action(func(opts []Opt) error { return nil })
+	menu.Option("A", nil, false, nil)
+	menu.Option("B", nil, false, nil)
+	menu.Option("C", nil, false, nil)
+	err := menu.Run()
+	require.True(t, IsOverMaxErr(err))
+	assert.Equal(t, "too few responses: 1 of at least 2\n", stdErr.String())
+}
+
+func TestDisabledOption(t *testing.T) {
+	for _, c := range disabledCases {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Where to?")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		menu.Action(func(opts []Opt) error {
+			var actual []string
+			for _, opt := range opts {
+				actual = append(actual, opt.Text)
+			}
+			return errors.New(strings.Join(actual, " "))
+		})
+		menu.Option("Deploy to dev", nil, true, nil)
+		menu.DisabledOption("Deploy to prod", nil, "requires VPN")
+		menu.DisabledOption("Deploy to qa", nil, "")
+		menu.Option("Deploy to staging", nil, false, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+		if c.input == "2\r\n" {
+			assert.True(t, IsDisabledErr(err))
+			assert.Equal(t, "requires VPN", err.(*MenuError).Reason)
+			lines := strings.Split(stdOut.String(), "\n")
+			assert.Equal(t, "1) *Deploy to dev", lines[0])
+			assert.Equal(t, "2) Deploy to prod (requires VPN)", lines[1])
+			assert.Equal(t, "3) Deploy to qa", lines[2])
+		}
+	}
+}
+
+func TestDisabledNeverDefault(t *testing.T) {
+	menu := NewMenu("Testing")
+	menu.DisabledOption("Disabled", nil, "")
+	menu.options[0].isDefault = true
+	assert.Empty(t, menu.getDefault())
+}
+
+func TestExclusiveAndRequires(t *testing.T) {
+	for _, c := range groupCases {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Pick components")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		menu.Exclusive("Postgres", "MySQL")
+		menu.Requires("Django", "Postgres")
+		menu.Requires("Celery", "Django", "Redis")
+		menu.Action(func(opts []Opt) error {
+			var actual []string
+			for _, opt := range opts {
+				actual = append(actual, opt.Text)
+			}
+			return errors.New(strings.Join(actual, " "))
+		})
+		menu.Option("Postgres", nil, false, nil)
+		menu.Option("MySQL", nil, false, nil)
+		menu.Option("Django", nil, false, nil)
+		menu.Option("Redis", nil, false, nil)
+		menu.Option("Celery", nil, false, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+	}
+}
+
+func TestUnknownTitle(t *testing.T) {
+	for _, c := range []struct {
+		exclusive []string
+		requires  []string
+		expected  string
+	}{
+		{[]string{"Postgres", "MySql"}, nil, "MySql"},
+		{nil, []string{"Djnago", "Postgres"}, "Djnago"},
+		{nil, []string{"Django", "Postgress"}, "Postgress"},
+	} {
+		stdOut := initTest()
+		reader := strings.NewReader("1\r\n")
+		menu := NewMenu("Pick components")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		if c.exclusive != nil {
+			menu.Exclusive(c.exclusive...)
+		}
+		if c.requires != nil {
+			menu.Requires(c.requires[0], c.requires[1:]...)
+		}
+		called := false
+		menu.Action(func(opts []Opt) error {
+			called = true
+			return nil
+		})
+		menu.Option("Postgres", nil, false, nil)
+		menu.Option("MySQL", nil, false, nil)
+		menu.Option("Django", nil, false, nil)
+		err := menu.Run()
+		if assert.True(t, IsUnknownTitleErr(err), c.expected) {
+			assert.Equal(t, c.expected, err.(*MenuError).Res)
+		}
+		assert.False(t, called, c.expected)
+		assert.Empty(t, stdOut.String(), c.expected)
+	}
+}
+
+func TestDefaultsValidated(t *testing.T) {
+	for _, c := range []struct {
+		exclusive bool
+		max       int
+		expected  string
+	}{
+		{false, 0, "a b"},
+		{false, 1, "more than the maximum responses: 2 of at most 1"},
+		{true, 0, "conflicting responses: a, b"},
+	} {
+		stdOut := initTest()
+		reader := strings.NewReader("\r\n")
+		menu := NewMenu("Testing")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		menu.SetMaxSelections(c.max)
+		if c.exclusive {
+			menu.Exclusive("a", "b")
+		}
+		menu.Action(func(opts []Opt) error {
+			var actual []string
+			for _, opt := range opts {
+				actual = append(actual, opt.Text)
+			}
+			return errors.New(strings.Join(actual, " "))
+		})
+		menu.Option("a", nil, true, nil)
+		menu.Option("b", nil, true, nil)
+		menu.Option("c", nil, false, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error())
+	}
+}
+
+func TestValidator(t *testing.T) {
+	errBoth := errors.New("can not delete and archive the same project")
+	for _, c := range []struct {
+		input    string
+		expected string
+	}{
+		{"1\r\n", "Delete"},
+		{"1 2\r\n", "can not delete and archive the same project: 1 2"},
+		{"\r\n", "can not delete and archive the same project"},
+		{"1 1\r\n", "duplicated response: 1"},
+	} {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Testing")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		menu.Validator(func(opts []Opt) error {
+			if len(opts) == 2 {
+				return errBoth
+			}
+			return nil
+		})
+		menu.Action(func(opts []Opt) error {
+			return errors.New(opts[0].Text)
+		})
+		menu.Option("Delete", nil, true, nil)
+		menu.Option("Archive", nil, true, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+	}
+}
+
+func TestValidatorLoop(t *testing.T) {
+	stdOut := initTest()
+	stdErr := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("1 2\r\n2\r\n"))
+	called := false
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdErr)
+	menu.AllowMultiple()
+	menu.LoopOnInvalid()
+	menu.Validator(func(opts []Opt) error {
+		if len(opts) > 1 {
+			return newMenuError(ErrTooMany, "pick one", 0)
+		}
+		return nil
+	})
+	menu.Action(func(opts []Opt) error {
+		called = true
+		assert.Equal(t, "Archive", opts[0].Text)
+		return nil
+	})
+	menu.Option("Delete", nil, false, nil)
+	menu.Option("Archive", nil, false, nil)
+	require.NoError(t, menu.Run())
+	assert.True(t, called)
+	assert.Equal(t, "too many responses: pick one\n", stdErr.String())
+	//the tries are put back once the menu is done
+	assert.Equal(t, 3, menu.tries)
+}
+
+func TestConfirmSelection(t *testing.T) {
+	for _, c := range []struct {
+		input    string
+		expected string
+	}{
+		{"1 3\r\ny\r\n", "A C"},
+		{"1 3\r\nyes\r\n", "A C"},
+		{"1 3\r\nn\r\n", "selection canceled: n"},
+		{"1 3\r\n\r\n", "selection canceled"},
+		{"1 3\r\nmaybe\r\n", "invalid response: maybe"},
+		{"1 3\r\ne\r\n\r\ny\r\n", "A C"},
+		{"1 3\r\nedit\r\n2\r\ny\r\n", "B"},
+		{"\r\ny\r\n", "B"},
+	} {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		menu := NewMenu("Delete which?")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		menu.ConfirmSelection()
+		menu.Action(func(opts []Opt) error {
+			var actual []string
+			for _, opt := range opts {
+				actual = append(actual, opt.Text)
+			}
+			return errors.New(strings.Join(actual, " "))
+		})
+		menu.Option("A", nil, false, nil)
+		menu.Option("B", nil, true, nil)
+		menu.Option("C", nil, false, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+		assert.Equal(t, []int{1}, menu.defaults(), "defaults should be restored")
+	}
+}
+
+func TestConfirmSelectionEdit(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("1 3\r\ne\r\n\r\ny\r\n"))
+	menu := NewMenu("Delete which?")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.AllowMultiple()
+	menu.ConfirmSelection()
+	menu.Action(func(opts []Opt) error { return nil })
+	menu.Option("A", nil, false, nil)
+	menu.Option("B", nil, true, nil)
+	menu.Option("C", nil, false, nil)
+	require.NoError(t, menu.Run())
+	assert.Equal(t, strings.Join([]string{
+		"1) A",
+		"2) *B",
+		"3) C",
+		"Delete which?",
+		"You selected: A, C. Proceed? (y/N/e)",
+		"1) *A",
+		"2) B",
+		"3) *C",
+		"Delete which?",
+		"You selected: A, C. Proceed? (y/N/e)",
+		"",
+	}, "\n"), stdOut.String())
+}
+
+func TestConfirmSelectionInvalid(t *testing.T) {
+	stdOut := initTest()
+	stdErr := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("1 2\r\nmaybe\r\ny\r\n"))
+	menu := NewMenu("Delete which?")
+	menu.ChangeReaderWriter(reader, stdOut, stdErr)
+	menu.AllowMultiple()
+	menu.LoopOnInvalid()
+	menu.ConfirmSelection()
+	var actual []string
+	menu.Action(func(opts []Opt) error {
+		for _, opt := range opts {
+			actual = append(actual, opt.Text)
+		}
+		return nil
+	})
+	menu.Option("A", nil, false, nil)
+	menu.Option("B", nil, false, nil)
+	require.NoError(t, menu.Run())
+	assert.Equal(t, []string{"A", "B"}, actual)
+	assert.Equal(t, "invalid response: maybe\n", stdErr.String())
+	assert.Equal(t, strings.Join([]string{
+		"1) A",
+		"2) B",
+		"Delete which?",
+		"You selected: A, B. Proceed? (y/N/e)",
+		"You selected: A, B. Proceed? (y/N/e)",
+		"",
+	}, "\n"), stdOut.String())
+}
+
+func TestConfirmSelectionWords(t *testing.T) {
+	for _, c := range []struct {
+		input    string
+		expected string
+	}{
+		{"1\r\nj\r\n", "A"},
+		{"1\r\nb\r\n2\r\nja\r\n", "B"},
+		{"1\r\ny\r\n", "invalid response: y"},
+		{"1\r\nn\r\n", "selection canceled: n"},
+	} {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		menu := NewMenu("Löschen?")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.SetYesNoWords(GermanYesNo)
+		menu.ConfirmSelection()
+		menu.Action(func(opts []Opt) error { return errors.New(opts[0].Text) })
+		menu.Option("A", nil, false, nil)
+		menu.Option("B", nil, false, nil)
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+		assert.Contains(t, stdOut.String(), "Ausgewählt: A. Fortfahren? (j/N/b)\n", c.input)
+	}
+}
+
+func TestOptionPhrase(t *testing.T) {
+	for _, c := range []struct {
+		input    string
+		expected string
+	}{
+		{"2\r\nprod-db\r\n", "dropped prod-db"},
+		{"2\r\n prod-db \r\n", "dropped prod-db"},
+		{"2\r\nprod\r\n", "phrase does not match: prod"},
+		{"2\r\nPROD-DB\r\n", "phrase does not match: PROD-DB"},
+		{"1\r\n", "kept"},
+		{"\r\nprod-db\r\n", "dropped prod-db"},
+		{"1 2\r\nnope\r\n", "phrase does not match: nope"},
+	} {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		menu := NewMenu("What now?")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		menu.Action(func(opts []Opt) error { return errors.New("kept") })
+		menu.Option("Keep", nil, false, nil)
+		menu.OptionPhrase("prod-db", "Drop database", "prod-db", true, func(opt Opt) error {
+			return errors.New("dropped " + opt.Value.(string))
+		})
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+	}
+}
+
+func TestOptionPhrasePrompt(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("1\r\nwrong\r\n"))
+	menu := NewMenu("What now?")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.OptionPhrase("prod-db", "Drop database", nil, false, func(opt Opt) error {
+		assert.Fail(t, "Should not have called the option's function")
+		return nil
+	})
+	err := menu.Run()
+	require.True(t, IsPhraseMismatchErr(err))
+	assert.Equal(t, "1) Drop database\nWhat now?\nType \"prod-db\" to confirm Drop database.\n", stdOut.String())
+}
+
+func TestOptionOther(t *testing.T) {
+	for _, c := range []struct {
+		input    string
+		loop     bool
+		expected []interface{}
+		err      error
+	}{
+		{"3\r\nA friend\r\n", false, []interface{}{"A friend"}, nil},
+		{"1 3\r\n  A friend \r\n", false, []interface{}{"search", "A friend"}, nil},
+		{"1\r\n", false, []interface{}{"search"}, nil},
+		{"3\r\n\r\n", false, nil, ErrNoResponse},
+		{"3\r\n\r\nA friend\r\n", true, []interface{}{"A friend"}, nil},
+		{"3\r\n\r\n\r\n\r\n", true, nil, ErrNoResponse},
+	} {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		menu := NewMenu("How did you hear about us?")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		if c.loop {
+			menu.LoopOnInvalid()
+		}
+		var actual []interface{}
+		menu.Action(func(opts []Opt) error {
+			for _, opt := range opts {
+				actual = append(actual, opt.Value)
+			}
+			return nil
+		})
+		menu.Option("Search engine", "search", false, nil)
+		menu.Option("Advertisement", "ad", false, nil)
+		menu.OptionOther("Other (please specify)", "Please specify:", nil)
+		err := menu.Run()
+		if c.err != nil {
+			if assert.IsType(t, &MenuError{}, err, c.input) {
+				assert.Equal(t, c.err, err.(*MenuError).Err, c.input)
+			}
+		} else {
+			assert.NoError(t, err, c.input)
+		}
+		assert.Equal(t, c.expected, actual, c.input)
+	}
+}
+
+func TestOptionOtherConfirmEdit(t *testing.T) {
+	for _, c := range []struct {
+		input    string
+		expected []interface{}
+	}{
+		{"3\r\nA friend\r\ne\r\n\r\ny\r\n", []interface{}{"A friend"}},
+		{"1 3\r\nA friend\r\ne\r\n\r\ny\r\n", []interface{}{"search", "A friend"}},
+		{"3\r\nA friend\r\ne\r\n3\r\nA blog\r\ny\r\n", []interface{}{"A blog"}},
+		{"3\r\nA friend\r\ne\r\n1\r\ny\r\n", []interface{}{"search"}},
+	} {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		menu := NewMenu("How did you hear about us?")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		menu.ConfirmSelection()
+		var actual []interface{}
+		menu.Action(func(opts []Opt) error {
+			for _, opt := range opts {
+				actual = append(actual, opt.Value)
+			}
+			return nil
+		})
+		menu.Option("Search engine", "search", false, nil)
+		menu.Option("Advertisement", "ad", false, nil)
+		menu.OptionOther("Other (please specify)", "Please specify:", nil)
+		err := menu.Run()
+		require.NoError(t, err, c.input)
+		assert.Equal(t, c.expected, actual, c.input)
+		assert.Empty(t, menu.getDefault(), c.input)
+	}
+}
+
+func TestOptionOtherPrompt(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("2\r\n\r\nA friend\r\n"))
+	menu := NewMenu("How did you hear about us?")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.LoopOnInvalid()
+	menu.Option("Search engine", "search", false, nil)
+	menu.OptionOther("Other (please specify)", "Please specify:", func(opt Opt) error {
+		assert.Equal(t, "A friend", opt.Value)
+		return nil
+	})
+	err := menu.Run()
+	require.NoError(t, err)
+	assert.Equal(t, "1) Search engine\n2) Other (please specify)\nHow did you hear about us?\nPlease specify:\nno response\nPlease specify:\n", stdOut.String())
+}
+
+func TestNoneWithoutAction(t *testing.T) {
+	stdOut := initTest()
+	reader := strings.NewReader("none\r\n")
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.AllowMultiple()
+	menu.Option("A", nil, true, func(Opt) error {
+		assert.Fail(t, "Should not have called the default option")
+		return nil
+	})
+	err := menu.Run()
+	assert.True(t, IsNoResponseErr(err))
+}
+
+func TestOptionKey(t *testing.T) {
+	menu := NewMenu("Testing")
+	menu.Option("Save", nil, false, nil)
+	require.NoError(t, menu.OptionKey("q", "Quit", nil, false, nil))
+	assert.Equal(t, "q", menu.options[1].Key)
+	assert.Equal(t, 1, menu.options[1].ID)
+	assert.True(t, IsKeyCollisionErr(menu.OptionKey("q", "Quit Again", nil, false, nil)))
+	assert.True(t, IsKeyCollisionErr(menu.OptionKey("3", "Three", nil, false, nil)))
+	assert.True(t, IsKeyCollisionErr(menu.OptionKey("", "Empty", nil, false, nil)))
+	assert.NoError(t, menu.OptionKey("Q", "Really Quit", nil, false, nil))
+	assert.True(t, IsKeyCollisionErr(menu.OptionKey("all", "All", nil, false, nil)))
+	assert.True(t, IsKeyCollisionErr(menu.OptionKey("None", "None", nil, false, nil)))
+	assert.True(t, IsKeyCollisionErr(menu.OptionKey("!", "Bang", nil, false, nil)))
+	assert.Len(t, menu.options, 3)
+}
+
+func TestKeywordKeyCollision(t *testing.T) {
+	stdOut := initTest()
+	reader := strings.NewReader("every\r\n")
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.AllowMultiple()
+	menu.Option("Save", nil, false, nil)
+	require.NoError(t, menu.OptionKey("every", "Every", nil, false, nil))
+	menu.SetAllKeyword("every")
+	err := menu.Run()
+	assert.True(t, IsKeyCollisionErr(err))
+}
+
+func TestKeyResponse(t *testing.T) {
+	for _, c := range keyCases {
+		stdOut := initTest()
+		reader := strings.NewReader(c.input)
+		menu := NewMenu("Testing")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.Action(func(opts []Opt) error {
+			return errors.New(opts[0].Text)
+		})
+		menu.Option("Save", nil, false, nil)
+		require.NoError(t, menu.OptionKey("q", "Quit", nil, false, nil))
+		menu.Option("Load", nil, false, nil)
+		require.NoError(t, menu.OptionKey("b", "back", nil, false, nil))
+		require.NoError(t, menu.OptionKey("B", "Back", nil, false, nil))
+		err := menu.Run()
+		require.Error(t, err)
+		assert.Equal(t, c.expected, err.Error(), c.input)
+	}
+}
+
+func TestKeyPrint(t *testing.T) {
+	stdOut := initTest()
+	reader := strings.NewReader("\r\n")
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.PadOptionID()
+	menu.Option("Save", nil, true, nil)
+	require.NoError(t, menu.OptionKey("quit", "Quit", nil, false, nil))
+	menu.Option("Load", nil, false, nil)
+	_ = menu.Run()
+
+	lines := strings.Split(stdOut.String(), "\n")
+	assert.Equal(t, "   1) *Save", lines[0])
+	assert.Equal(t, "quit) Quit", lines[1])
+	assert.Equal(t, "   2) Load", lines[2])
+}
+
+func TestLetterLabels(t *testing.T) {
+	stdOut := initTest()
+	reader := strings.NewReader("a-b D\r\n")
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.SetLabelScheme(LowerLetterLabels)
+	menu.AllowMultiple()
+	menu.Action(func(opts []Opt) error {
+		var actual []string
+		for _, opt := range opts {
+			actual = append(actual, opt.Text)
+		}
+		assert.Equal(t, []string{"Apple", "Banana", "Dragon Fruit"}, actual)
+		return nil
+	})
+	menu.Option("Apple", nil, false, nil)
+	menu.Option("Banana", nil, false, nil)
+	menu.Option("Cherry", nil, false, nil)
+	menu.Option("Dragon Fruit", nil, false, nil)
+	err := menu.Run()
+	require.NoError(t, err)
+
+	lines := strings.Split(stdOut.String(), "\n")
+	assert.Equal(t, "a) Apple", lines[0])
+	assert.Equal(t, "d) Dragon Fruit", lines[3])
+}
+
+func TestLetterLabelPadding(t *testing.T) {
+	stdOut := initTest()
+	reader := strings.NewReader("aa\r\n")
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.SetLabelScheme(UpperLetterLabels)
+	menu.PadOptionID()
+	menu.Action(func(opts []Opt) error {
+		assert.Equal(t, "Option 27", opts[0].Text)
+		return nil
+	})
+	for i := 1; i <= 27; i++ {
+		menu.Option(fmt.Sprintf("Option %d", i), nil, false, nil)
+	}
+	err := menu.Run()
+	require.NoError(t, err)
+
+	lines := strings.Split(stdOut.String(), "\n")
+	assert.Equal(t, " A) Option 1", lines[0])
+	assert.Equal(t, "AA) Option 27", lines[26])
+}
+
+func TestLabelSchemeKeyCollision(t *testing.T) {
+	stdOut := initTest()
+	reader := strings.NewReader("q\r\n")
+	menu := NewMenu("Testing")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.Option("Save", nil, false, nil)
+	require.NoError(t, menu.OptionKey("q", "Quit", nil, false, nil))
+	menu.SetLabelScheme(LowerLetterLabels)
+	assert.True(t, IsKeyCollisionErr(menu.OptionKey("x", "Exit", nil, false, nil)))
+	err := menu.Run()
+	assert.True(t, IsKeyCollisionErr(err))
+	assert.Empty(t, stdOut.String())
+}
+
 func initTest() *bytes.Buffer {
 	var b []byte
 	return bytes.NewBuffer(b)
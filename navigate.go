@@ -0,0 +1,178 @@
+package wmenu
+
+import "strings"
+
+// navigation is what an option added by Navigate does when it is selected.
+type navigation int
+
+const (
+	navNone navigation = iota
+	navBack
+	navQuit
+)
+
+// Submenu adds an option that opens child when it is selected while navigating (see Navigate).
+// A submenu option has to be selected on its own and is left out when all options are selected.
+// Otherwise this works the same as Option.
+func (m *Menu) Submenu(title string, value interface{}, child *Menu) {
+	option := newOption(len(m.options), title, value, false, nil)
+	option.submenu = child
+	m.options = append(m.options, *option)
+}
+
+// SetBackOption sets the key and title of the option that goes back to the parent menu while navigating.
+// Default is <) Back so it can not collide with the labels of any LabelScheme. Use an empty key to not add the option.
+func (m *Menu) SetBackOption(key, title string) {
+	m.backKey = key
+	m.backTitle = title
+}
+
+// SetQuitOption sets the key and title of the option that stops navigating.
+// Default is .) Quit so it can not collide with the labels of any LabelScheme. Use an empty key to not add the option.
+func (m *Menu) SetQuitOption(key, title string) {
+	m.quitKey = key
+	m.quitTitle = title
+}
+
+// ShowBreadcrumb will show where the user is above the options while navigating (IE Main > Food > Toppings).
+// root is shown first, followed by the text of each submenu option selected. Use "" to only show the options.
+// It stays at the top of the screen when ClearOnMenuRun is activated.
+func (m *Menu) ShowBreadcrumb(root string) {
+	m.breadcrumb = true
+	m.breadcrumbRoot = root
+}
+
+// Navigate runs the menu and the submenus added with Submenu as a tree.
+// Selecting a submenu option opens that menu, with a Back option to return to the menu it was opened from.
+// Every menu has a Quit option which returns an error of ErrQuit.
+// Once options without a submenu are selected they are called like Run would, and the path of options
+// selected to get there is returned with them at the end.
+// Every menu is asked with the reader, writer, and colors of this menu (phrases of OptionPhrase as well), and the Back and Quit options of this menu.
+// The breadcrumb of this menu is used as well if ShowBreadcrumb is activated.
+// Yes/no menus (see IsYesNo) can not be navigated and return an error of ErrNotNavigable.
+// Errors are of type MenuError unless returned by a function of an option.
+func (m *Menu) Navigate() ([]Opt, error) {
+	path, leaf, options, restore, err := m.navigate()
+	if err != nil {
+		return path, err
+	}
+	defer restore()
+	return path, leaf.callAppropriate(options)
+}
+
+// RunLoop runs the menu again each time the functions of the selected options return (IE the main menu of a tool).
+// It works like Navigate so submenus can be used as well.
+// The loop stops without an error when the Quit option is selected or a function returns ErrQuit
+// (as is or as the Err of a MenuError).
+// Any other error returned by a function is written and the menu is shown again.
+// Errors from selecting the options (IE running out of tries) stop the loop and are returned.
+func (m *Menu) RunLoop() error {
+	for {
+		_, leaf, options, restore, err := m.navigate()
+		if IsQuitErr(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = leaf.callAppropriate(options)
+		restore()
+		if err == ErrQuit || IsQuitErr(err) {
+			return nil
+		}
+		if err != nil {
+			m.ui.Error(err.Error())
+		}
+	}
+}
+
+// Asks through the tree until options without a submenu are selected.
+// The options are returned along with the menu they were selected from so its functions can be called.
+// The menu still uses the UI of m until restore is called so its functions ask (IE OptionPhrase) with it as well.
+func (m *Menu) navigate() (path []Opt, leaf *Menu, options []Opt, restore func(), err error) {
+	stack := []*Menu{m}
+	for {
+		current := stack[len(stack)-1]
+		restore, err := current.navigateLevel(m, path)
+		var options []Opt
+		if err == nil {
+			options, err = current.selectOptions()
+		}
+		if err != nil {
+			restore()
+			return path, nil, nil, nil, err
+		}
+		selected := options
+		if selected == nil {
+			selected = current.getDefault()
+		}
+		if len(selected) == 1 {
+			switch {
+			case selected[0].nav == navBack:
+				restore()
+				stack = stack[:len(stack)-1]
+				path = path[:len(path)-1]
+				continue
+			case selected[0].nav == navQuit:
+				restore()
+				return path, nil, nil, nil, newMenuError(ErrQuit, "", 0)
+			case selected[0].submenu != nil:
+				restore()
+				stack = append(stack, selected[0].submenu)
+				path = append(path, selected[0])
+				continue
+			}
+		}
+		return append(path, selected...), current, options, restore, nil
+	}
+}
+
+// Sets up one menu of the tree that was reached by path to be asked.
+// The Back and Quit options and the breadcrumb of root are added until restore is called.
+func (m *Menu) navigateLevel(root *Menu, path []Opt) (restore func(), err error) {
+	ui, header, count := m.ui, m.header, len(m.options)
+	restore = func() {
+		m.ui, m.header, m.options = ui, header, m.options[:count]
+	}
+	m.ui = root.ui
+	if m.isYN {
+		return restore, newMenuError(ErrNotNavigable, "", 0)
+	}
+	if root.breadcrumb {
+		m.header = root.crumbs(path)
+	}
+	if len(path) > 0 && root.backKey != "" {
+		if err := m.navigationOption(root.backKey, root.backTitle, navBack); err != nil {
+			return restore, err
+		}
+	}
+	if root.quitKey != "" {
+		if err := m.navigationOption(root.quitKey, root.quitTitle, navQuit); err != nil {
+			return restore, err
+		}
+	}
+	return restore, nil
+}
+
+func (m *Menu) navigationOption(key, title string, nav navigation) error {
+	if err := m.checkKey(key); err != nil {
+		return err
+	}
+	option := newOption(len(m.options), title, nil, false, nil)
+	option.Key = key
+	option.nav = nav
+	m.options = append(m.options, *option)
+	return nil
+}
+
+// Joins the breadcrumb root and the text of each option in path.
+func (m *Menu) crumbs(path []Opt) string {
+	var crumbs []string
+	if m.breadcrumbRoot != "" {
+		crumbs = append(crumbs, m.breadcrumbRoot)
+	}
+	for _, opt := range path {
+		crumbs = append(crumbs, opt.Text)
+	}
+	return strings.Join(crumbs, " > ")
+}
@@ -0,0 +1,302 @@
+package wmenu
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func navigateTestMenu(called *string) *Menu {
+	action := func(opts []Opt) error {
+		var texts []string
+		for _, opt := range opts {
+			texts = append(texts, opt.Text)
+		}
+		*called = strings.Join(texts, ",")
+		return nil
+	}
+	pizza := NewMenu("Topping?")
+	pizza.AllowMultiple()
+	pizza.Option("Meat", nil, false, nil)
+	pizza.Option("Cheese", nil, true, nil)
+	pizza.Action(action)
+	iceCream := NewMenu("Topping?")
+	iceCream.Option("Fruit", nil, false, nil)
+	iceCream.Action(action)
+	dessert := NewMenu("Dessert?")
+	dessert.Submenu("Ice Cream", nil, iceCream)
+	dessert.Action(action)
+	menu := NewMenu("Food?")
+	menu.Submenu("Pizza", nil, pizza)
+	menu.Submenu("Dessert", nil, dessert)
+	menu.Option("Water", nil, false, nil)
+	menu.Action(action)
+	return menu
+}
+
+func TestNavigate(t *testing.T) {
+	for _, c := range []struct {
+		input  string
+		path   string
+		called string
+		err    error
+	}{
+		{"1\r\n1 2\r\n", "Pizza,Meat,Cheese", "Meat,Cheese", nil},
+		{"1\r\n\r\n", "Pizza,Cheese", "Cheese", nil},
+		{"3\r\n", "Water", "Water", nil},
+		{"2\r\n1\r\n1\r\n", "Dessert,Ice Cream,Fruit", "Fruit", nil},
+		{"2\r\n<\r\n1\r\nall\r\n", "Pizza,Meat,Cheese", "Meat,Cheese", nil},
+		{"2\r\n1\r\n<\r\n<\r\n3\r\n", "Water", "Water", nil},
+		{"2\r\n.\r\n", "Dessert", "", ErrQuit},
+		{"<\r\n", "", "", ErrInvalid},
+		{"1 3\r\n", "", "", ErrTooMany},
+		{"1\r\n1 <\r\n", "Pizza", "", ErrTooMany},
+	} {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		var called string
+		menu := navigateTestMenu(&called)
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.AllowMultiple()
+		path, err := menu.Navigate()
+		if c.err != nil {
+			if assert.IsType(t, &MenuError{}, err, c.input) {
+				assert.Equal(t, c.err, err.(*MenuError).Err, c.input)
+			}
+		} else {
+			assert.NoError(t, err, c.input)
+		}
+		var texts []string
+		for _, opt := range path {
+			texts = append(texts, opt.Text)
+		}
+		assert.Equal(t, c.path, strings.Join(texts, ","), c.input)
+		assert.Equal(t, c.called, called, c.input)
+	}
+}
+
+func TestNavigatePrint(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("2\r\nx\r\n1\r\n1\r\n"))
+	var called string
+	menu := navigateTestMenu(&called)
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.SetBackOption("u", "Up")
+	menu.SetQuitOption("", "")
+	dessert := menu.options[1].submenu
+	dessert.LoopOnInvalid()
+	dessert.ChangeReaderWriter(strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{})
+	_, err := menu.Navigate()
+	require.NoError(t, err)
+	assert.Equal(t, "Fruit", called)
+	expected := "1) Pizza\n2) Dessert\n3) Water\nFood?\n" +
+		"1) Ice Cream\nu) Up\nDessert?\ninvalid response: x\n" +
+		"1) Ice Cream\nu) Up\nDessert?\n" +
+		"1) Fruit\nu) Up\nTopping?\n"
+	assert.Equal(t, expected, stdOut.String())
+	assert.Len(t, dessert.options, 1)
+}
+
+func TestNavigateKeyCollision(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("1\r\n"))
+	child := NewMenu("Child?")
+	require.NoError(t, child.OptionKey("<", "Up", nil, false, nil))
+	menu := NewMenu("Root?")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.Submenu("Child", nil, child)
+	_, err := menu.Navigate()
+	assert.True(t, IsKeyCollisionErr(err))
+}
+
+func TestNavigateBreadcrumb(t *testing.T) {
+	for _, c := range []struct {
+		root     string
+		clear    bool
+		expected string
+	}{
+		{"Main", false, "Main\n1) Pizza\n2) Dessert\n3) Water\nFood?\n" +
+			"Main > Dessert\n1) Ice Cream\nDessert?\ninvalid response: x\n" +
+			"Main > Dessert\n1) Ice Cream\nDessert?\n" +
+			"Main > Dessert > Ice Cream\n1) Fruit\nTopping?\n"},
+		{"", false, "1) Pizza\n2) Dessert\n3) Water\nFood?\n" +
+			"Dessert\n1) Ice Cream\nDessert?\ninvalid response: x\n" +
+			"Dessert\n1) Ice Cream\nDessert?\n" +
+			"Dessert > Ice Cream\n1) Fruit\nTopping?\n"},
+		{"Main", true, "Main\n1) Pizza\n2) Dessert\n3) Water\nFood?\n" +
+			"Main > Dessert\n1) Ice Cream\nDessert?\n" +
+			"Main > Dessert\ninvalid response: x\n1) Ice Cream\nDessert?\n" +
+			"Main > Dessert > Ice Cream\n1) Fruit\nTopping?\n"},
+	} {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader("2\r\nx\r\n1\r\n1\r\n"))
+		var called string
+		menu := navigateTestMenu(&called)
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.SetBackOption("", "")
+		menu.SetQuitOption("", "")
+		menu.ShowBreadcrumb(c.root)
+		for _, m := range []*Menu{menu, menu.options[1].submenu, menu.options[1].submenu.options[0].submenu} {
+			m.LoopOnInvalid()
+			if c.clear {
+				m.ClearOnMenuRun()
+			}
+		}
+		_, err := menu.Navigate()
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, stdOut.String(), c.root)
+	}
+}
+
+func TestRunLoop(t *testing.T) {
+	for _, c := range []struct {
+		input    string
+		expected []string
+		errs     string
+		err      error
+	}{
+		{"1\r\n2\r\n.\r\n", []string{"Deploy", "Rollback"}, "", nil},
+		{"2\r\n3\r\n1\r\n.\r\n", []string{"Rollback", "Fail", "Deploy"}, "deploy failed\n", nil},
+		{"1\r\n4\r\n1\r\n", []string{"Deploy", "Stop"}, "", nil},
+		{"x\r\n1\r\nx\r\n2\r\n.\r\n", []string{"Deploy", "Rollback"}, "invalid response: x\ninvalid response: x\n", nil},
+		{"x\r\nx\r\nx\r\n", nil, "invalid response: x\ninvalid response: x\n", ErrInvalid},
+		{"1\r\n", []string{"Deploy"}, "EOF\nEOF\n", io.EOF},
+	} {
+		stdOut := initTest()
+		stdErr := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		menu := NewMenu("Action?")
+		menu.ChangeReaderWriter(reader, stdOut, stdErr)
+		menu.LoopOnInvalid()
+		var actual []string
+		menu.Action(func(opts []Opt) error {
+			actual = append(actual, opts[0].Text)
+			return nil
+		})
+		menu.Option("Deploy", nil, false, nil)
+		menu.Option("Rollback", nil, false, nil)
+		menu.Option("Fail", nil, false, func(opt Opt) error {
+			actual = append(actual, opt.Text)
+			return errors.New("deploy failed")
+		})
+		menu.Option("Stop", nil, false, func(opt Opt) error {
+			actual = append(actual, opt.Text)
+			return ErrQuit
+		})
+		err := menu.RunLoop()
+		if c.err != nil {
+			if assert.IsType(t, &MenuError{}, err, c.input) {
+				assert.Equal(t, c.err, err.(*MenuError).Err, c.input)
+			}
+		} else {
+			assert.NoError(t, err, c.input)
+		}
+		assert.Equal(t, c.expected, actual, c.input)
+		assert.Equal(t, c.errs, stdErr.String(), c.input)
+		assert.Equal(t, 3, menu.tries, c.input)
+		assert.Len(t, menu.options, 4, c.input)
+	}
+}
+
+func TestRunLoopSubmenu(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("2\r\n1\r\n1\r\n3\r\n.\r\n"))
+	var called string
+	menu := navigateTestMenu(&called)
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	var calls []string
+	menu.Action(func(opts []Opt) error {
+		calls = append(calls, opts[0].Text)
+		return nil
+	})
+	require.NoError(t, menu.RunLoop())
+	assert.Equal(t, "Fruit", called)
+	assert.Equal(t, []string{"Water"}, calls)
+}
+
+func TestNavigatePhrase(t *testing.T) {
+	for _, loop := range []bool{false, true} {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader("1\r\n1\r\nprod\r\n.\r\n"))
+		var dropped interface{}
+		child := NewMenu("Database?")
+		child.ChangeReaderWriter(strings.NewReader(""), &bytes.Buffer{}, &bytes.Buffer{})
+		child.OptionPhrase("prod", "Drop prod", "prod", false, func(opt Opt) error {
+			dropped = opt.Value
+			return nil
+		})
+		menu := NewMenu("Action?")
+		menu.ChangeReaderWriter(reader, stdOut, stdOut)
+		menu.Submenu("Drop", nil, child)
+		var err error
+		if loop {
+			err = menu.RunLoop()
+		} else {
+			_, err = menu.Navigate()
+		}
+		require.NoError(t, err)
+		assert.Equal(t, "prod", dropped)
+		assert.Contains(t, stdOut.String(), "Type \"prod\" to confirm Drop prod.\n")
+		assert.Len(t, child.options, 1)
+	}
+}
+
+func TestNavigateLetterLabels(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("b\r\n<\r\nc\r\n"))
+	var called string
+	menu := navigateTestMenu(&called)
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	for _, m := range []*Menu{menu, menu.options[1].submenu} {
+		m.SetLabelScheme(LowerLetterLabels)
+	}
+	path, err := menu.Navigate()
+	require.NoError(t, err)
+	assert.Len(t, path, 1)
+	assert.Equal(t, "Water", called)
+	expected := "a) Pizza\nb) Dessert\nc) Water\n.) Quit\nFood?\n" +
+		"a) Ice Cream\n<) Back\n.) Quit\nDessert?\n" +
+		"a) Pizza\nb) Dessert\nc) Water\n.) Quit\nFood?\n"
+	assert.Equal(t, expected, stdOut.String())
+}
+
+func TestRunLoopQuitErr(t *testing.T) {
+	for _, quit := range []error{ErrQuit, newMenuError(ErrQuit, "", 0), &MenuError{Err: ErrQuit}} {
+		stdOut := initTest()
+		stdErr := initTest()
+		reader := iotest.OneByteReader(strings.NewReader("1\r\n1\r\n"))
+		menu := NewMenu("Action?")
+		menu.ChangeReaderWriter(reader, stdOut, stdErr)
+		menu.Option("Stop", nil, false, func(opt Opt) error {
+			return quit
+		})
+		assert.NoError(t, menu.RunLoop(), quit.Error())
+		assert.Empty(t, stdErr.String(), quit.Error())
+	}
+}
+
+func TestNavigateYesNo(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("1\r\ny\r\n"))
+	child := NewMenu("Sure?")
+	child.IsYesNo(DefN)
+	child.Action(func(opts []Opt) error { return nil })
+	menu := NewMenu("Action?")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.Submenu("Delete", nil, child)
+	_, err := menu.Navigate()
+	assert.True(t, IsNotNavigableErr(err))
+	assert.Empty(t, child.options)
+
+	menu = NewMenu("Sure?")
+	menu.ChangeReaderWriter(reader, stdOut, stdOut)
+	menu.IsYesNo(DefY)
+	menu.Action(func(opts []Opt) error { return nil })
+	assert.True(t, IsNotNavigableErr(menu.RunLoop()))
+}
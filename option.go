@@ -2,12 +2,20 @@ package wmenu
 
 // Opt is what Menu uses to display options to screen.
 // Also holds information on what should run and if it is a default option
+// Key is only set when the option is selected by a key instead of a number (see Menu.OptionKey).
 type Opt struct {
-	ID        int
-	Text      string
-	Value     interface{}
-	function  func(Opt) error
-	isDefault bool
+	ID         int
+	Text       string
+	Value      interface{}
+	Key        string
+	function   func(Opt) error
+	isDefault  bool
+	isDisabled bool
+	reason     string
+	phrase     string
+	other      string
+	submenu    *Menu
+	nav        navigation
 }
 
 func newOption(id int, text string, value interface{}, def bool, function func(Opt) error) *Opt {
@@ -19,3 +27,16 @@ func newOption(id int, text string, value interface{}, def bool, function func(O
 		function:  function,
 	}
 }
+
+// Text shown for a disabled option, with the reason it is disabled if there is one.
+func (o Opt) disabledText() string {
+	if o.reason == "" {
+		return o.Text
+	}
+	return o.Text + " (" + o.reason + ")"
+}
+
+// Whether selecting the option moves to another menu instead of calling a function.
+func (o Opt) navigates() bool {
+	return o.submenu != nil || o.nav != navNone
+}
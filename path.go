@@ -0,0 +1,130 @@
+package wmenu
+
+import (
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// PathCheck is what a PathPrompt requires of the path.
+type PathCheck int
+
+const (
+	//PathAny accepts any path
+	PathAny PathCheck = iota
+	//PathExists requires the path to exist
+	PathExists
+	//PathNotExists requires the path to not exist (IE a new output file)
+	PathNotExists
+	//PathFile requires the path to be an existing file
+	PathFile
+	//PathDir requires the path to be an existing directory
+	PathDir
+)
+
+// PathPrompt is a Prompt for a file or directory path.
+// A leading ~ and environment variables (IE $HOME) are expanded and the path is cleaned before it is checked and returned.
+// When reading from a terminal pressing tab completes the path using the entries of its directory.
+// A path that fails its check is an error of ErrInvalid.
+type PathPrompt struct {
+	Prompt
+	check PathCheck
+}
+
+// NewPathPrompt creates a PathPrompt with a wlog.UI as the writer.
+// Default is PathAny.
+func NewPathPrompt(question string) *PathPrompt {
+	p := &PathPrompt{Prompt: *NewPrompt(question)}
+	p.convert = expandPath
+	p.complete = completePath
+	p.Validator(func(res string) error {
+		return p.checkPath(res)
+	})
+	return p
+}
+
+// Require sets what is required of the path.
+func (p *PathPrompt) Require(check PathCheck) {
+	p.check = check
+}
+
+func (p *PathPrompt) checkPath(path string) error {
+	if p.check == PathAny {
+		return nil
+	}
+	info, err := os.Stat(path)
+	if p.check == PathNotExists {
+		if err == nil {
+			return invalidReason(path, "already exists")
+		}
+		return nil
+	}
+	if err != nil {
+		return invalidReason(path, "does not exist")
+	}
+	if p.check == PathFile && info.IsDir() {
+		return invalidReason(path, "is not a file")
+	}
+	if p.check == PathDir && !info.IsDir() {
+		return invalidReason(path, "is not a directory")
+	}
+	return nil
+}
+
+// expandPath expands a leading ~ to the home directory and environment variables.
+// The path is cleaned so it only uses the separator of the OS (IE $HOME/out on windows).
+func expandPath(path string) string {
+	path = os.ExpandEnv(path)
+	if path != "~" && !strings.HasPrefix(path, "~/") && !strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		return filepath.Clean(path)
+	}
+	home := os.Getenv("HOME")
+	if u, err := user.Current(); home == "" && err == nil {
+		home = u.HomeDir
+	}
+	if home == "" {
+		return filepath.Clean(path)
+	}
+	return filepath.Clean(home + path[1:])
+}
+
+// completePath completes path to the longest prefix shared by the entries of its directory that start with it.
+// Directories are completed with a trailing separator.
+// What was typed is kept as is (IE ~ is not expanded) so only the completion is added.
+func completePath(path string) (string, []string) {
+	dir, base := "", path
+	if i := strings.LastIndexAny(path, "/"+string(filepath.Separator)); i >= 0 {
+		dir, base = path[:i+1], path[i+1:]
+	}
+	entries, err := ioutil.ReadDir(expandPath(dir))
+	if err != nil {
+		return path, nil
+	}
+	var matches []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, base) || (strings.HasPrefix(name, ".") && !strings.HasPrefix(base, ".")) {
+			continue
+		}
+		if entry.IsDir() {
+			name += string(filepath.Separator)
+		}
+		matches = append(matches, name)
+	}
+	if len(matches) == 0 {
+		return path, nil
+	}
+	sort.Strings(matches)
+	prefix := matches[0]
+	for _, match := range matches[1:] {
+		for !strings.HasPrefix(match, prefix) {
+			_, size := utf8.DecodeLastRuneInString(prefix)
+			prefix = prefix[:len(prefix)-size]
+		}
+	}
+	return dir + prefix, matches
+}
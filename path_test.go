@@ -0,0 +1,113 @@
+package wmenu
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pathTestDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "wmenu")
+	require.NoError(t, err)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "configs"), 0700))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "config.d"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "config.yaml"), nil, 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".hidden"), nil, 0600))
+	return dir
+}
+
+func TestPathPrompt(t *testing.T) {
+	dir := pathTestDir(t)
+	defer os.RemoveAll(dir)
+	os.Setenv("WMENU_TEST_DIR", dir)
+	defer os.Unsetenv("WMENU_TEST_DIR")
+	file := filepath.Join(dir, "config.yaml")
+	cases := []struct {
+		input    string
+		check    PathCheck
+		expected string
+		reason   string
+	}{
+		{"$WMENU_TEST_DIR/config.yaml", PathFile, file, ""},
+		{file, PathExists, file, ""},
+		{file, PathDir, "", "is not a directory"},
+		{file, PathNotExists, "", "already exists"},
+		{dir, PathFile, "", "is not a file"},
+		{dir, PathDir, dir, ""},
+		{"${WMENU_TEST_DIR}/out.yaml", PathNotExists, filepath.Join(dir, "out.yaml"), ""},
+		{filepath.Join(dir, "out.yaml"), PathExists, "", "does not exist"},
+		{filepath.Join(dir, "out.yaml"), PathAny, filepath.Join(dir, "out.yaml"), ""},
+	}
+	for _, c := range cases {
+		stdOut := initTest()
+		prompt := NewPathPrompt("Config file?")
+		prompt.ChangeReaderWriter(strings.NewReader(c.input+"\r\n"), stdOut, stdOut)
+		prompt.Require(c.check)
+		actual, err := prompt.Run()
+		if c.reason == "" {
+			assert.NoError(t, err, c.input)
+		} else if assert.True(t, IsInvalidErr(err), c.input) {
+			assert.Equal(t, c.reason, err.(*MenuError).Reason, c.input)
+		}
+		assert.Equal(t, c.expected, actual, c.input)
+	}
+}
+
+func TestExpandPath(t *testing.T) {
+	home := os.Getenv("HOME")
+	defer os.Setenv("HOME", home)
+	os.Setenv("HOME", "/home/wmenu")
+	os.Setenv("WMENU_TEST_DIR", "/tmp/wmenu")
+	defer os.Unsetenv("WMENU_TEST_DIR")
+	assert.Equal(t, filepath.FromSlash("/home/wmenu"), expandPath("~"))
+	assert.Equal(t, filepath.FromSlash("/home/wmenu/.config"), expandPath("~/.config"))
+	assert.Equal(t, filepath.FromSlash("~wmenu/.config"), expandPath("~wmenu/.config"))
+	assert.Equal(t, filepath.FromSlash("/tmp/wmenu/out"), expandPath("$WMENU_TEST_DIR/out"))
+	assert.Equal(t, "out", expandPath("./out"))
+	assert.Equal(t, ".", expandPath(""))
+}
+
+func TestCompletePath(t *testing.T) {
+	dir := pathTestDir(t)
+	defer os.RemoveAll(dir)
+	sep := string(filepath.Separator)
+	cases := []struct {
+		input      string
+		expected   string
+		candidates []string
+	}{
+		{dir + sep + "conf", dir + sep + "config", []string{"config.d" + sep, "config.yaml", "configs" + sep}},
+		{dir + sep + "config.y", dir + sep + "config.yaml", []string{"config.yaml"}},
+		{dir + sep + "configs", dir + sep + "configs" + sep, []string{"configs" + sep}},
+		{dir + sep + ".h", dir + sep + ".hidden", []string{".hidden"}},
+		{dir + sep + "out", dir + sep + "out", nil},
+		{dir + sep + "missing" + sep + "c", dir + sep + "missing" + sep + "c", nil},
+	}
+	for _, c := range cases {
+		actual, candidates := completePath(c.input)
+		assert.Equal(t, c.expected, actual, c.input)
+		assert.Equal(t, c.candidates, candidates, c.input)
+	}
+}
+
+func TestReadLine(t *testing.T) {
+	complete := func(res string) (string, []string) {
+		switch res {
+		case "co":
+			return "config", []string{"config.d", "config.yaml"}
+		case "config.y":
+			return "config.yaml", []string{"config.yaml"}
+		}
+		return res, nil
+	}
+	stdOut := initTest()
+	actual, err := readLine(strings.NewReader("co\t.y\t\x7f\x7f\x7f\x7fyaml\x01\r\nnext\r\n"), stdOut, complete)
+	assert.NoError(t, err)
+	assert.Equal(t, "config.yaml", actual)
+	assert.Equal(t, "co\nconfig.d  config.yaml\nconfig.yaml\b \b\b \b\b \b\b \byaml\n", stdOut.String())
+}
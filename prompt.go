@@ -0,0 +1,226 @@
+package wmenu
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/dixonwille/wlog/v3"
+)
+
+// Prompt is used to ask the user for free text (IE a branch name).
+// It will validate the response the same way a Menu does, asking again if LoopOnInvalid is activated.
+type Prompt struct {
+	asker
+	question   string
+	def        string
+	hasDef     bool
+	trim       string
+	validators []func(string) error
+	reader     io.Reader
+	writer     io.Writer
+	errWriter  io.Writer
+	secret     bool
+	confirm    string
+	complete   completer
+	convert    func(string) string
+}
+
+// NewPrompt creates a prompt with a wlog.UI as the writer.
+func NewPrompt(question string) *Prompt {
+	return &Prompt{
+		asker:      newAsker(),
+		question:   question,
+		def:        "",
+		hasDef:     false,
+		trim:       " \t",
+		validators: nil,
+		reader:     os.Stdin,
+		writer:     os.Stdout,
+		errWriter:  os.Stderr,
+		secret:     false,
+		confirm:    "",
+		complete:   nil,
+		convert:    nil,
+	}
+}
+
+// AddColor will change the color of the prompt.
+// questionColor changes the color of the question.
+// responseColor changes the color of the response.
+// errorColor changes the color of the errors.
+// Use wlog.None if you do not want to change the color.
+func (p *Prompt) AddColor(questionColor, responseColor, errorColor wlog.Color) {
+	if !noColor {
+		//the question is written as output when it is not asked by the UI (IE before tab completion or an editor)
+		p.ui = wlog.AddColor(questionColor, errorColor, wlog.None, wlog.None, questionColor, responseColor, wlog.None, wlog.None, wlog.None, p.ui)
+	}
+}
+
+// ClearOnPromptRun will clear the screen when a prompt is ran.
+// This is checked when LoopOnInvalid is activated.
+// Meaning if an error occurred then it will clear the screen before asking again.
+func (p *Prompt) ClearOnPromptRun() {
+	p.clear = true
+}
+
+// SetTries sets the number of tries on the loop before failing out.
+// Default is 3.
+// Negative values act like 0.
+func (p *Prompt) SetTries(i int) {
+	p.tries = i
+}
+
+// LoopOnInvalid is used if an invalid response was given then it will prompt the user again.
+func (p *Prompt) LoopOnInvalid() {
+	p.loopOnInvalid = true
+}
+
+// ChangeReaderWriter changes where the prompt listens and writes to.
+// reader is where user input is collected.
+// writer and errorWriter is where the prompt should write to.
+func (p *Prompt) ChangeReaderWriter(reader io.Reader, writer, errorWriter io.Writer) {
+	p.ui = wlog.New(reader, writer, errorWriter)
+	p.reader = reader
+	p.writer = writer
+	p.errWriter = errorWriter
+}
+
+// Default sets the value used when the response is empty.
+// It is shown after the question (IE Branch name? (main)) and is not validated.
+func (p *Prompt) Default(value string) {
+	p.def = value
+	p.hasDef = true
+}
+
+// SetTrim sets the characters trimmed from both ends of the response.
+// Default is spaces and tabs. Use an empty string to keep the response as is.
+func (p *Prompt) SetTrim(cutset string) {
+	p.trim = cutset
+}
+
+// Pattern makes the response match re.
+// A response that does not match is an error of ErrInvalid.
+func (p *Prompt) Pattern(re *regexp.Regexp) {
+	p.validators = append(p.validators, func(res string) error {
+		if !re.MatchString(res) {
+			return invalidReason(res, "must match "+re.String())
+		}
+		return nil
+	})
+}
+
+// Validator adds a check on the response.
+// Returning an error is treated like an invalid response, so it counts against the tries and is asked again if LoopOnInvalid is activated.
+// Errors that are not a MenuError are wrapped in one with the response.
+// Unlike a Menu, a prompt can have more than one Validator and they are ran in the order they were added.
+func (p *Prompt) Validator(function func(string) error) {
+	p.validators = append(p.validators, function)
+}
+
+// Run is used to execute the prompt.
+// It will ask the question and return the validated response.
+// It will only clear the screen if ClearOnPromptRun is activated.
+// Errors are of type MenuError.
+func (p *Prompt) Run() (string, error) {
+	if p.clear {
+		Clear()
+	}
+	for {
+		res, err := p.ask()
+		if err == nil {
+			return res, nil
+		}
+		if err = p.failed(err); err != nil {
+			return "", err
+		}
+	}
+}
+
+func (p *Prompt) ask() (string, error) {
+	res, err := p.askValid()
+	if e, ok := err.(*MenuError); ok && p.secret {
+		//never show a secret in an error
+		e.Res = ""
+	}
+	return res, err
+}
+
+func (p *Prompt) askValid() (string, error) {
+	question := p.question
+	if p.hasDef && !p.secret {
+		question += " (" + p.def + ")"
+	}
+	res, err := p.read(question)
+	if err != nil {
+		return "", err
+	}
+	res, err = p.validate(res)
+	if err != nil {
+		return "", err
+	}
+	if p.confirm != "" {
+		again, err := p.read(p.confirm)
+		if err != nil {
+			return "", err
+		}
+		if again != res {
+			return "", newMenuError(ErrMismatch, "", p.triesLeft())
+		}
+	}
+	return res, nil
+}
+
+// validate uses the default for an empty response, otherwise it converts the response and runs the validators on it.
+func (p *Prompt) validate(res string) (string, error) {
+	if res == "" {
+		if p.hasDef {
+			return p.converted(p.def), nil
+		}
+		return "", newMenuError(ErrNoResponse, "", p.triesLeft())
+	}
+	res = p.converted(res)
+	for _, validator := range p.validators {
+		err := validator(res)
+		if err == nil {
+			continue
+		}
+		if e, ok := err.(*MenuError); ok {
+			e.TriesLeft = p.triesLeft()
+			return "", e
+		}
+		return "", newMenuError(err, res, p.triesLeft())
+	}
+	return res, nil
+}
+
+func (p *Prompt) converted(res string) string {
+	if p.convert == nil {
+		return res
+	}
+	return p.convert(res)
+}
+
+// read asks question and trims the response.
+// Secret prompts do not echo the response when reading from a terminal.
+// Prompts with a completer complete the response when tab is pressed on a terminal.
+func (p *Prompt) read(question string) (string, error) {
+	if p.complete != nil {
+		ask := func() { p.ui.Output(question) }
+		if res, ok, err := readCompleted(p.reader, p.writer, ask, p.complete); ok {
+			return strings.Trim(res, p.trim), err
+		}
+	}
+	if !p.secret {
+		res, err := p.ui.Ask(question, "")
+		return strings.Trim(res, p.trim), err
+	}
+	restore := hideInput(p.reader)
+	res, err := p.ui.Ask(question, "")
+	if restore() {
+		//the enter key was not echoed either
+		p.ui.Output("")
+	}
+	return strings.Trim(res, p.trim), err
+}
@@ -0,0 +1,87 @@
+package wmenu
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errReserved = errors.New("reserved name")
+
+var promptCases = []struct {
+	input    string
+	def      *string
+	trim     *string
+	loop     bool
+	expected string
+	err      error
+}{
+	{"feature/x\r\n", nil, nil, false, "feature/x", nil},
+	{"  feature/x \t\r\n", nil, nil, false, "feature/x", nil},
+	{"  feature/x\r\n", nil, strPtr(""), false, "  feature/x", nil},
+	{"\r\n", strPtr("main"), nil, false, "main", nil},
+	{"  \r\n", nil, nil, false, "", ErrNoResponse},
+	{"bad name\r\n", nil, nil, false, "", ErrInvalid},
+	{"master\r\n", nil, nil, false, "", errReserved},
+	{"bad name\r\nmaster\r\nfix-1\r\n", nil, nil, true, "fix-1", nil},
+	{"a b\r\nc d\r\ne f\r\ng h\r\n", nil, nil, true, "", ErrInvalid},
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestPrompt(t *testing.T) {
+	for _, c := range promptCases {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		prompt := NewPrompt("Branch name?")
+		prompt.ChangeReaderWriter(reader, stdOut, stdOut)
+		if c.def != nil {
+			prompt.Default(*c.def)
+		}
+		if c.trim != nil {
+			prompt.SetTrim(*c.trim)
+		}
+		if c.loop {
+			prompt.LoopOnInvalid()
+		}
+		prompt.Pattern(regexp.MustCompile(`^ *[^ ]+$`))
+		prompt.Validator(func(res string) error {
+			if res == "master" {
+				return errReserved
+			}
+			return nil
+		})
+		actual, err := prompt.Run()
+		if c.err != nil {
+			if assert.IsType(t, &MenuError{}, err, c.input) {
+				assert.Equal(t, c.err, err.(*MenuError).Err, c.input)
+			}
+		} else {
+			assert.NoError(t, err, c.input)
+		}
+		assert.Equal(t, c.expected, actual, c.input)
+	}
+}
+
+func TestPromptQuestion(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("bad name\r\n\r\n"))
+	prompt := NewPrompt("Branch name?")
+	prompt.ChangeReaderWriter(reader, stdOut, stdOut)
+	prompt.Default("main")
+	prompt.LoopOnInvalid()
+	prompt.Pattern(regexp.MustCompile(`^[^ ]+$`))
+	actual, err := prompt.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, "main", actual)
+
+	lines := strings.Split(stdOut.String(), "\n")
+	assert.Equal(t, "Branch name? (main)", lines[0])
+	assert.Equal(t, "invalid response: bad name (must match ^[^ ]+$)", lines[1])
+}
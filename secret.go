@@ -0,0 +1,22 @@
+package wmenu
+
+// SecretPrompt is a Prompt for passwords and tokens.
+// When reading from a terminal the response is not echoed.
+// When the input is piped (IE through ChangeReaderWriter) it is read like any other Prompt.
+// The response is never shown, so a Default is not shown after the question and errors do not hold the response.
+type SecretPrompt struct {
+	Prompt
+}
+
+// NewSecretPrompt creates a SecretPrompt with a wlog.UI as the writer.
+func NewSecretPrompt(question string) *SecretPrompt {
+	p := &SecretPrompt{Prompt: *NewPrompt(question)}
+	p.secret = true
+	return p
+}
+
+// Confirm makes the user type the secret again after question.
+// If the two do not match it is an error of ErrMismatch.
+func (p *SecretPrompt) Confirm(question string) {
+	p.confirm = question
+}
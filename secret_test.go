@@ -0,0 +1,75 @@
+package wmenu
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var secretCases = []struct {
+	input    string
+	confirm  bool
+	loop     bool
+	expected string
+	err      error
+}{
+	{"hunter22\r\n", false, false, "hunter22", nil},
+	{"hunter22\r\nhunter22\r\n", true, false, "hunter22", nil},
+	{"hunter22\r\nhunter2\r\n", true, false, "", ErrMismatch},
+	{"hunter22\r\nhunter2\r\nhunter22\r\nhunter22\r\n", true, true, "hunter22", nil},
+	{"short\r\n", false, false, "", ErrInvalid},
+	{"\r\n", false, false, "", ErrNoResponse},
+}
+
+func TestSecretPrompt(t *testing.T) {
+	for _, c := range secretCases {
+		stdOut := initTest()
+		reader := iotest.OneByteReader(strings.NewReader(c.input))
+		prompt := NewSecretPrompt("Token?")
+		prompt.ChangeReaderWriter(reader, stdOut, stdOut)
+		if c.confirm {
+			prompt.Confirm("Again?")
+		}
+		if c.loop {
+			prompt.LoopOnInvalid()
+		}
+		prompt.Pattern(regexp.MustCompile(`^.{8,}$`))
+		actual, err := prompt.Run()
+		if c.err != nil {
+			if assert.IsType(t, &MenuError{}, err, c.input) {
+				assert.Equal(t, c.err, err.(*MenuError).Err, c.input)
+				assert.Empty(t, err.(*MenuError).Res, c.input)
+			}
+		} else {
+			assert.NoError(t, err, c.input)
+		}
+		assert.Equal(t, c.expected, actual, c.input)
+	}
+}
+
+func TestSecretPromptHidden(t *testing.T) {
+	stdOut := initTest()
+	reader := iotest.OneByteReader(strings.NewReader("hunter2\r\n\r\n"))
+	prompt := NewSecretPrompt("Token?")
+	prompt.ChangeReaderWriter(reader, stdOut, stdOut)
+	prompt.Default("hunter22")
+	prompt.LoopOnInvalid()
+	prompt.Validator(func(res string) error {
+		if len(res) < 8 {
+			return errors.New("too short")
+		}
+		return nil
+	})
+	actual, err := prompt.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, "hunter22", actual)
+	assert.NotContains(t, stdOut.String(), "hunter2")
+
+	lines := strings.Split(stdOut.String(), "\n")
+	assert.Equal(t, "Token?", lines[0])
+	assert.Equal(t, "too short", lines[1])
+}
@@ -0,0 +1,95 @@
+package wmenu
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestions is the most options that will be suggested for an invalid response.
+const maxSuggestions = 2
+
+// suggest ranks the options against an invalid response and returns the indexes of the closest ones.
+// An option is close if it is only a few edits away from the response or contains it as a subsequence.
+// Disabled options and the Back and Quit options of Navigate are never suggested.
+func suggest(response string, options []Opt) []int {
+	res := strings.ToLower(strings.TrimSpace(response))
+	if res == "" {
+		return nil
+	}
+	type candidate struct {
+		index    int
+		distance int
+	}
+	maxDistance := len([]rune(res)) / 3
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+	var candidates []candidate
+	for i, opt := range options {
+		if opt.isDisabled || opt.navigates() {
+			continue
+		}
+		text := strings.ToLower(opt.Text)
+		distance := editDistance(res, text)
+		if distance <= maxDistance || (len([]rune(res)) > 1 && isSubsequence(res, text)) {
+			candidates = append(candidates, candidate{i, distance})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+	var suggestions []int
+	for i := 0; i < len(candidates) && i < maxSuggestions; i++ {
+		suggestions = append(suggestions, candidates[i].index)
+	}
+	return suggestions
+}
+
+// editDistance returns the number of single character edits needed to turn a into b.
+// Swapping two neighbouring characters counts as a single edit since it is a common typo.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	rows := make([][]int, len(ra)+1)
+	for i := range rows {
+		rows[i] = make([]int, len(rb)+1)
+		rows[i][0] = i
+	}
+	for j := range rows[0] {
+		rows[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			rows[i][j] = min3(rows[i-1][j]+1, rows[i][j-1]+1, rows[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] && rows[i-2][j-2]+1 < rows[i][j] {
+				rows[i][j] = rows[i-2][j-2] + 1
+			}
+		}
+	}
+	return rows[len(ra)][len(rb)]
+}
+
+// isSubsequence checks if every character of sub appears in s in the same order.
+func isSubsequence(sub, s string) bool {
+	rs := []rune(sub)
+	i := 0
+	for _, r := range s {
+		if i < len(rs) && rs[i] == r {
+			i++
+		}
+	}
+	return i == len(rs)
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
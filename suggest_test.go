@@ -0,0 +1,50 @@
+package wmenu
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var editDistanceCases = []struct {
+	a        string
+	b        string
+	expected int
+}{
+	{"", "", 0},
+	{"abc", "", 3},
+	{"", "abc", 3},
+	{"staging", "staging", 0},
+	{"stagnig", "staging", 1},
+	{"dve", "dev", 1},
+	{"prd", "prod", 1},
+	{"kitten", "sitting", 3},
+}
+
+var suggestCases = []struct {
+	response string
+	expected []int
+}{
+	{"stagnig", []int{0}},
+	{"prd", []int{1}},
+	{"stg", []int{0}},
+	{"STAGIN", []int{0}},
+	{"dve", []int{2}},
+	{"xyz", nil},
+	{"", nil},
+	{"bakc", nil},
+	{"stable", nil},
+}
+
+func TestEditDistance(t *testing.T) {
+	for _, c := range editDistanceCases {
+		assert.Equal(t, c.expected, editDistance(c.a, c.b), "%s -> %s", c.a, c.b)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	options := []Opt{{Text: "staging"}, {Text: "prod"}, {Text: "dev"}, {Text: "stable", isDisabled: true}, {Text: "Back", nav: navBack}}
+	for _, c := range suggestCases {
+		assert.Equal(t, c.expected, suggest(c.response, options), c.response)
+	}
+}
@@ -0,0 +1,252 @@
+package wmenu
+
+import (
+	"strconv"
+	"time"
+)
+
+// IntPrompt is a Prompt whose response is parsed as a whole number.
+// Responses that can not be parsed or are out of bounds are an error of ErrInvalid.
+type IntPrompt struct {
+	Prompt
+	min, max       int
+	hasMin, hasMax bool
+}
+
+// NewIntPrompt creates an IntPrompt with a wlog.UI as the writer.
+func NewIntPrompt(question string) *IntPrompt {
+	p := &IntPrompt{Prompt: *NewPrompt(question)}
+	p.Validator(func(res string) error {
+		_, err := p.parse(res)
+		return err
+	})
+	return p
+}
+
+// Default sets the number used when the response is empty.
+func (p *IntPrompt) Default(value int) {
+	p.Prompt.Default(strconv.Itoa(value))
+}
+
+// SetMin sets the smallest number that is accepted.
+func (p *IntPrompt) SetMin(min int) {
+	p.min = min
+	p.hasMin = true
+}
+
+// SetMax sets the largest number that is accepted.
+func (p *IntPrompt) SetMax(max int) {
+	p.max = max
+	p.hasMax = true
+}
+
+// Run is used to execute the prompt.
+// It will ask the question and return the parsed response.
+// Errors are of type MenuError.
+func (p *IntPrompt) Run() (int, error) {
+	res, err := p.Prompt.Run()
+	if err != nil {
+		return 0, err
+	}
+	return p.parse(res)
+}
+
+func (p *IntPrompt) parse(res string) (int, error) {
+	i, err := strconv.Atoi(res)
+	if err != nil {
+		return 0, invalidReason(res, "not a whole number")
+	}
+	if p.hasMin && i < p.min {
+		return 0, invalidReason(res, "must be at least "+strconv.Itoa(p.min))
+	}
+	if p.hasMax && i > p.max {
+		return 0, invalidReason(res, "must be at most "+strconv.Itoa(p.max))
+	}
+	return i, nil
+}
+
+// FloatPrompt is a Prompt whose response is parsed as a number.
+// Responses that can not be parsed or are out of bounds are an error of ErrInvalid.
+type FloatPrompt struct {
+	Prompt
+	min, max       float64
+	hasMin, hasMax bool
+}
+
+// NewFloatPrompt creates a FloatPrompt with a wlog.UI as the writer.
+func NewFloatPrompt(question string) *FloatPrompt {
+	p := &FloatPrompt{Prompt: *NewPrompt(question)}
+	p.Validator(func(res string) error {
+		_, err := p.parse(res)
+		return err
+	})
+	return p
+}
+
+// Default sets the number used when the response is empty.
+func (p *FloatPrompt) Default(value float64) {
+	p.Prompt.Default(formatFloat(value))
+}
+
+// SetMin sets the smallest number that is accepted.
+func (p *FloatPrompt) SetMin(min float64) {
+	p.min = min
+	p.hasMin = true
+}
+
+// SetMax sets the largest number that is accepted.
+func (p *FloatPrompt) SetMax(max float64) {
+	p.max = max
+	p.hasMax = true
+}
+
+// Run is used to execute the prompt.
+// It will ask the question and return the parsed response.
+// Errors are of type MenuError.
+func (p *FloatPrompt) Run() (float64, error) {
+	res, err := p.Prompt.Run()
+	if err != nil {
+		return 0, err
+	}
+	return p.parse(res)
+}
+
+func (p *FloatPrompt) parse(res string) (float64, error) {
+	f, err := strconv.ParseFloat(res, 64)
+	if err != nil {
+		return 0, invalidReason(res, "not a number")
+	}
+	if p.hasMin && f < p.min {
+		return 0, invalidReason(res, "must be at least "+formatFloat(p.min))
+	}
+	if p.hasMax && f > p.max {
+		return 0, invalidReason(res, "must be at most "+formatFloat(p.max))
+	}
+	return f, nil
+}
+
+// DurationPrompt is a Prompt whose response is parsed with time.ParseDuration (IE 1h30m).
+// Responses that can not be parsed or are out of bounds are an error of ErrInvalid.
+type DurationPrompt struct {
+	Prompt
+	min, max       time.Duration
+	hasMin, hasMax bool
+}
+
+// NewDurationPrompt creates a DurationPrompt with a wlog.UI as the writer.
+func NewDurationPrompt(question string) *DurationPrompt {
+	p := &DurationPrompt{Prompt: *NewPrompt(question)}
+	p.Validator(func(res string) error {
+		_, err := p.parse(res)
+		return err
+	})
+	return p
+}
+
+// Default sets the duration used when the response is empty.
+func (p *DurationPrompt) Default(value time.Duration) {
+	p.Prompt.Default(value.String())
+}
+
+// SetMin sets the shortest duration that is accepted.
+func (p *DurationPrompt) SetMin(min time.Duration) {
+	p.min = min
+	p.hasMin = true
+}
+
+// SetMax sets the longest duration that is accepted.
+func (p *DurationPrompt) SetMax(max time.Duration) {
+	p.max = max
+	p.hasMax = true
+}
+
+// Run is used to execute the prompt.
+// It will ask the question and return the parsed response.
+// Errors are of type MenuError.
+func (p *DurationPrompt) Run() (time.Duration, error) {
+	res, err := p.Prompt.Run()
+	if err != nil {
+		return 0, err
+	}
+	return p.parse(res)
+}
+
+func (p *DurationPrompt) parse(res string) (time.Duration, error) {
+	d, err := time.ParseDuration(res)
+	if err != nil {
+		return 0, invalidReason(res, "not a duration")
+	}
+	if p.hasMin && d < p.min {
+		return 0, invalidReason(res, "must be at least "+p.min.String())
+	}
+	if p.hasMax && d > p.max {
+		return 0, invalidReason(res, "must be at most "+p.max.String())
+	}
+	return d, nil
+}
+
+// TimePrompt is a Prompt whose response is parsed with time.Parse using its layout.
+// Responses that can not be parsed or are out of bounds are an error of ErrInvalid.
+type TimePrompt struct {
+	Prompt
+	layout         string
+	min, max       time.Time
+	hasMin, hasMax bool
+}
+
+// NewTimePrompt creates a TimePrompt that parses responses using layout (IE "2006-01-02").
+func NewTimePrompt(question, layout string) *TimePrompt {
+	p := &TimePrompt{Prompt: *NewPrompt(question), layout: layout}
+	p.Validator(func(res string) error {
+		_, err := p.parse(res)
+		return err
+	})
+	return p
+}
+
+// Default sets the time used when the response is empty.
+// It is shown using the layout of the prompt.
+func (p *TimePrompt) Default(value time.Time) {
+	p.Prompt.Default(value.Format(p.layout))
+}
+
+// SetMin sets the earliest time that is accepted.
+func (p *TimePrompt) SetMin(min time.Time) {
+	p.min = min
+	p.hasMin = true
+}
+
+// SetMax sets the latest time that is accepted.
+func (p *TimePrompt) SetMax(max time.Time) {
+	p.max = max
+	p.hasMax = true
+}
+
+// Run is used to execute the prompt.
+// It will ask the question and return the parsed response.
+// Errors are of type MenuError.
+func (p *TimePrompt) Run() (time.Time, error) {
+	res, err := p.Prompt.Run()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return p.parse(res)
+}
+
+func (p *TimePrompt) parse(res string) (time.Time, error) {
+	t, err := time.Parse(p.layout, res)
+	if err != nil {
+		return time.Time{}, invalidReason(res, "must look like "+p.layout)
+	}
+	if p.hasMin && t.Before(p.min) {
+		return time.Time{}, invalidReason(res, "must be at or after "+p.min.Format(p.layout))
+	}
+	if p.hasMax && t.After(p.max) {
+		return time.Time{}, invalidReason(res, "must be at or before "+p.max.Format(p.layout))
+	}
+	return t, nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
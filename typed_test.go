@@ -0,0 +1,123 @@
+package wmenu
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntPrompt(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected int
+		reason   string
+	}{
+		{"5\r\n", 5, ""},
+		{"\r\n", 3, ""},
+		{"five\r\n", 0, "not a whole number"},
+		{"0\r\n", 0, "must be at least 1"},
+		{"11\r\n", 0, "must be at most 10"},
+	}
+	for _, c := range cases {
+		stdOut := initTest()
+		prompt := NewIntPrompt("Replicas?")
+		prompt.ChangeReaderWriter(strings.NewReader(c.input), stdOut, stdOut)
+		prompt.Default(3)
+		prompt.SetMin(1)
+		prompt.SetMax(10)
+		actual, err := prompt.Run()
+		assertTyped(t, c.input, c.reason, err)
+		assert.Equal(t, c.expected, actual, c.input)
+	}
+}
+
+func TestFloatPrompt(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected float64
+		reason   string
+	}{
+		{"0.25\r\n", 0.25, ""},
+		{"\r\n", 0.5, ""},
+		{"half\r\n", 0, "not a number"},
+		{"1.5\r\n", 0, "must be at most 1"},
+	}
+	for _, c := range cases {
+		stdOut := initTest()
+		prompt := NewFloatPrompt("Ratio?")
+		prompt.ChangeReaderWriter(strings.NewReader(c.input), stdOut, stdOut)
+		prompt.Default(0.5)
+		prompt.SetMin(0)
+		prompt.SetMax(1)
+		actual, err := prompt.Run()
+		assertTyped(t, c.input, c.reason, err)
+		assert.Equal(t, c.expected, actual, c.input)
+	}
+}
+
+func TestDurationPrompt(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected time.Duration
+		reason   string
+	}{
+		{"1h30m\r\n", 90 * time.Minute, ""},
+		{"\r\n", 30 * time.Second, ""},
+		{"soon\r\n", 0, "not a duration"},
+		{"1s\r\n", 0, "must be at least 5s"},
+		{"2h\r\n", 0, "must be at most 1h30m0s"},
+	}
+	for _, c := range cases {
+		stdOut := initTest()
+		prompt := NewDurationPrompt("Timeout?")
+		prompt.ChangeReaderWriter(strings.NewReader(c.input), stdOut, stdOut)
+		prompt.Default(30 * time.Second)
+		prompt.SetMin(5 * time.Second)
+		prompt.SetMax(90 * time.Minute)
+		actual, err := prompt.Run()
+		assertTyped(t, c.input, c.reason, err)
+		assert.Equal(t, c.expected, actual, c.input)
+	}
+}
+
+func TestTimePrompt(t *testing.T) {
+	date := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	cases := []struct {
+		input    string
+		expected time.Time
+		reason   string
+	}{
+		{"2020-02-29\r\n", date("2020-02-29"), ""},
+		{"\r\n", date("2020-06-01"), ""},
+		{"02/29/2020\r\n", time.Time{}, "must look like 2006-01-02"},
+		{"2019-12-31\r\n", time.Time{}, "must be at or after 2020-01-01"},
+		{"2021-01-01\r\n", time.Time{}, "must be at or before 2020-12-31"},
+	}
+	for _, c := range cases {
+		stdOut := initTest()
+		prompt := NewTimePrompt("Release date?", "2006-01-02")
+		prompt.ChangeReaderWriter(strings.NewReader(c.input), stdOut, stdOut)
+		prompt.Default(date("2020-06-01"))
+		prompt.SetMin(date("2020-01-01"))
+		prompt.SetMax(date("2020-12-31"))
+		actual, err := prompt.Run()
+		assertTyped(t, c.input, c.reason, err)
+		assert.Equal(t, c.expected, actual, c.input)
+	}
+}
+
+func assertTyped(t *testing.T, input, reason string, err error) {
+	if reason == "" {
+		assert.NoError(t, err, input)
+		return
+	}
+	if assert.True(t, IsInvalidErr(err), input) {
+		assert.Equal(t, strings.TrimSpace(input), err.(*MenuError).Res, input)
+		assert.Equal(t, reason, err.(*MenuError).Reason, input)
+	}
+}
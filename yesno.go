@@ -0,0 +1,125 @@
+package wmenu
+
+import (
+	"regexp"
+	"strings"
+)
+
+// YesNoWords holds the answers accepted for a yes/no question and the hint added to the question.
+// Answers are matched exactly first and then without case.
+type YesNoWords struct {
+	Yes []string
+	No  []string
+	// DefYSuffix is added to the question when yes is the default (IE " (Y/n)").
+	DefYSuffix string
+	// DefNSuffix is added to the question otherwise (IE " (y/N)").
+	DefNSuffix string
+	// YesToAll and NoToAll are only accepted by a BatchConfirm.
+	YesToAll []string
+	NoToAll  []string
+	// ToAllSuffix is added to the question of a BatchConfirm after the other suffix.
+	ToAllSuffix string
+	// Edit is accepted by Menu.ConfirmSelection to change the selection. Leave it empty to not allow editing.
+	Edit []string
+	// Confirm is asked by Menu.ConfirmSelection with %s replaced by the selected options (IE "You selected: %s. Proceed?").
+	// When empty the English question is used.
+	Confirm string
+	// ConfirmSuffix is added to the Confirm question (IE " (y/N/e)"). When empty DefNSuffix is used.
+	ConfirmSuffix string
+	// None is shown by Menu.ConfirmSelection when no options were selected. When empty "none" is used.
+	None string
+}
+
+var (
+	// EnglishYesNo accepts yes, y, no and n. This is the default.
+	// A BatchConfirm also accepts all, a, none and N.
+	// ConfirmSelection also accepts edit and e to change the selection.
+	EnglishYesNo = YesNoWords{
+		Yes:           []string{"yes", "y"},
+		No:            []string{"no", "n"},
+		DefYSuffix:    " (Y/n)",
+		DefNSuffix:    " (y/N)",
+		YesToAll:      []string{"all", "a"},
+		NoToAll:       []string{"none", "N"},
+		ToAllSuffix:   " [a = yes to all, N = no to all]",
+		Edit:          []string{"edit", "e"},
+		Confirm:       "You selected: %s. Proceed?",
+		ConfirmSuffix: " (y/N/e)",
+		None:          "none",
+	}
+	// GermanYesNo accepts ja, j, nein and n.
+	// A BatchConfirm also accepts alle, a, keine and k.
+	// ConfirmSelection also accepts bearbeiten and b to change the selection.
+	GermanYesNo = YesNoWords{
+		Yes:           []string{"ja", "j"},
+		No:            []string{"nein", "n"},
+		DefYSuffix:    " (J/n)",
+		DefNSuffix:    " (j/N)",
+		YesToAll:      []string{"alle", "a"},
+		NoToAll:       []string{"keine", "k"},
+		ToAllSuffix:   " [a = ja für alle, k = nein für alle]",
+		Edit:          []string{"bearbeiten", "b"},
+		Confirm:       "Ausgewählt: %s. Fortfahren?",
+		ConfirmSuffix: " (j/N/b)",
+		None:          "keine",
+	}
+	// SpanishYesNo accepts sí, si, s, no and n.
+	// A BatchConfirm also accepts todos, t, ninguno and N.
+	// ConfirmSelection also accepts editar and e to change the selection.
+	SpanishYesNo = YesNoWords{
+		Yes:           []string{"sí", "si", "s"},
+		No:            []string{"no", "n"},
+		DefYSuffix:    " (S/n)",
+		DefNSuffix:    " (s/N)",
+		YesToAll:      []string{"todos", "t"},
+		NoToAll:       []string{"ninguno", "N"},
+		ToAllSuffix:   " [t = sí a todo, N = no a todo]",
+		Edit:          []string{"editar", "e"},
+		Confirm:       "Seleccionado: %s. ¿Continuar?",
+		ConfirmSuffix: " (s/N/e)",
+		None:          "ninguna",
+	}
+	// FrenchYesNo accepts oui, o, non and n.
+	// A BatchConfirm also accepts tous, t, aucun and N.
+	// ConfirmSelection also accepts modifier and m to change the selection.
+	FrenchYesNo = YesNoWords{
+		Yes:           []string{"oui", "o"},
+		No:            []string{"non", "n"},
+		DefYSuffix:    " (O/n)",
+		DefNSuffix:    " (o/N)",
+		YesToAll:      []string{"tous", "t"},
+		NoToAll:       []string{"aucun", "N"},
+		ToAllSuffix:   " [t = oui à tout, N = non à tout]",
+		Edit:          []string{"modifier", "m"},
+		Confirm:       "Sélection : %s. Continuer ?",
+		ConfirmSuffix: " (o/N/m)",
+		None:          "aucune",
+	}
+)
+
+// compile builds the patterns used to parse answers, the exact one first and then the one without case.
+// The groups match a yes, a no, and if toAll is set a yes to all and a no to all.
+func (w YesNoWords) compile(toAll bool) []*regexp.Regexp {
+	groups := `(` + quoteWords(w.Yes) + `)|(` + quoteWords(w.No) + `)`
+	if toAll {
+		groups += `|(` + quoteWords(w.YesToAll) + `)|(` + quoteWords(w.NoToAll) + `)`
+	}
+	return []*regexp.Regexp{
+		regexp.MustCompile(`^\s*(?:` + groups + `)\s*$`),
+		regexp.MustCompile(`^\s*(?i:` + groups + `)\s*$`),
+	}
+}
+
+func quoteWords(words []string) string {
+	var quoted []string
+	for _, word := range words {
+		if word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	//Nothing is accepted if there are no words
+	if len(quoted) == 0 {
+		return `[^\s\S]`
+	}
+	return strings.Join(quoted, "|")
+}